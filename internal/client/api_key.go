@@ -0,0 +1,190 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// APIKey representa un token de API de larga duración de un usuario
+type APIKey struct {
+	ID        string
+	UserID    string
+	Name      string
+	CreatedAt string
+	ExpiresAt string
+
+	// Token es el secreto del API key. Solo se devuelve al crearlo.
+	Token string
+}
+
+// apiKeyFromAPI convierte un API key de la API. Las fechas pueden llegar como
+// timestamp Unix o como cadena y se devuelven en formato RFC 3339.
+func apiKeyFromAPI(data map[string]interface{}) APIKey {
+	return APIKey{
+		ID:        stringValue(data["id"]),
+		UserID:    stringValue(data["user_id"]),
+		Name:      stringValue(data["name"]),
+		CreatedAt: timestampValue(data["created"]),
+		ExpiresAt: timestampValue(data["expires"]),
+		Token:     stringValue(data["token"]),
+	}
+}
+
+// CreateAPIKey crea un API key para el usuario. expiresInDays = 0 indica que no caduca.
+func (c *Client) CreateAPIKey(userID, name string, expiresInDays int64) (*APIKey, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s/api_key", c.HostURL, userID)
+
+	payload := map[string]interface{}{
+		"name": name,
+	}
+	if expiresInDays > 0 {
+		payload["expires_in_days"] = expiresInDays
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return nil, fmt.Errorf("error creando API key (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	key := apiKeyFromAPI(response)
+	if key.ID == "" || key.Token == "" {
+		return nil, fmt.Errorf("la respuesta no contiene el ID o el token del API key")
+	}
+	if key.UserID == "" {
+		key.UserID = userID
+	}
+
+	return &key, nil
+}
+
+// ListAPIKeys obtiene los API keys de un usuario (sin sus tokens)
+func (c *Client) ListAPIKeys(userID string) ([]APIKey, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s/api_keys", c.HostURL, userID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo API keys (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	keys := make([]APIKey, len(response))
+	for i, data := range response {
+		keys[i] = apiKeyFromAPI(data)
+		keys[i].Token = ""
+		if keys[i].UserID == "" {
+			keys[i].UserID = userID
+		}
+	}
+
+	return keys, nil
+}
+
+// GetAPIKey obtiene un API key de un usuario por su ID
+func (c *Client) GetAPIKey(userID, keyID string) (*APIKey, error) {
+	keys, err := c.ListAPIKeys(userID)
+	if err != nil {
+		if err.Error() == "user not found" {
+			return nil, fmt.Errorf("api_key not found")
+		}
+		return nil, err
+	}
+
+	for i := range keys {
+		if keys[i].ID == keyID {
+			return &keys[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("api_key not found")
+}
+
+// RevokeAPIKey revoca un API key. Un API key que ya no existe se considera revocado.
+func (c *Client) RevokeAPIKey(userID, keyID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s/api_key/%s", c.HostURL, userID, keyID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error revocando API key (status %d): %s", res.StatusCode, string(body))
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// execAuthTimeout es el tiempo máximo de ejecución del comando de autenticación "exec"
+const execAuthTimeout = 60 * time.Second
+
+// execStderrMaxLen es la longitud máxima de stderr que se incluye en los errores de "exec",
+// para no volcar en los diagnósticos la salida completa (que puede contener secretos)
+const execStderrMaxLen = 200
+
+// oidcHTTPClient es el cliente HTTP del proveedor de identidad. A diferencia de
+// Client.HTTPClient, verifica los certificados TLS, ya que se le envía el client secret.
+var oidcHTTPClient = &http.Client{Timeout: 60 * time.Second}
+
+// AuthConfig agrupa los datos de autenticación de los métodos que admite SignIn
+type AuthConfig struct {
+	// Method es el método de autenticación: token, form, oidc_client_credentials o exec
+	Method     string
+	CategoryID string
+
+	// form
+	Username string
+	Password string
+
+	// oidc_client_credentials
+	OIDCTokenURL     string
+	OIDCClientID     string
+	OIDCClientSecret string
+	OIDCScope        string
+
+	// exec
+	ExecCommand string
+	ExecArgs    []string
+	ExecEnv     map[string]string
+}
+
+// signInOIDCClientCredentials obtiene un access token con el flujo client credentials
+// de OAuth 2.0 en el token URL indicado y lo usa como token de la API
+func (c *Client) signInOIDCClientCredentials(auth AuthConfig) error {
+	tokenURL, err := url.Parse(auth.OIDCTokenURL)
+	if err != nil || tokenURL.Scheme != "https" || tokenURL.Host == "" {
+		return fmt.Errorf("el token URL OIDC debe ser una URL https: %q", auth.OIDCTokenURL)
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	if auth.OIDCScope != "" {
+		form.Set("scope", auth.OIDCScope)
+	}
+
+	req, err := http.NewRequest("POST", auth.OIDCTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	// client_secret_basic: las credenciales van codificadas en la cabecera (RFC 6749, 2.3.1)
+	req.SetBasicAuth(url.QueryEscape(auth.OIDCClientID), url.QueryEscape(auth.OIDCClientSecret))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := oidcHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error obteniendo token OIDC (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(body, &tokenResp); err != nil {
+		return fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	if tokenResp.AccessToken == "" {
+		return fmt.Errorf("la respuesta del token URL no contiene access_token")
+	}
+
+	c.Token = tokenResp.AccessToken
+	return nil
+}
+
+// signInExec ejecuta un comando externo y usa como token lo que escribe en la salida
+// estándar: el token en texto plano o un JSON con status.token (ExecCredential de
+// kubectl), token o access_token
+func (c *Client) signInExec(auth AuthConfig) error {
+	ctx, cancel := context.WithTimeout(context.Background(), execAuthTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, auth.ExecCommand, auth.ExecArgs...)
+	cmd.Env = os.Environ()
+	for key, value := range auth.ExecEnv {
+		cmd.Env = append(cmd.Env, key+"="+value)
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return fmt.Errorf("el comando %s no terminó en %s", auth.ExecCommand, execAuthTimeout)
+		}
+		if summary := execStderrSummary(stderr.String()); summary != "" {
+			return fmt.Errorf("error ejecutando %s: %w: %s", auth.ExecCommand, err, summary)
+		}
+		return fmt.Errorf("error ejecutando %s: %w", auth.ExecCommand, err)
+	}
+
+	token, err := tokenFromExecOutput(stdout.Bytes())
+	if err != nil {
+		return fmt.Errorf("salida no válida de %s: %w", auth.ExecCommand, err)
+	}
+
+	c.Token = token
+	return nil
+}
+
+// execStderrSummary devuelve la primera línea no vacía de stderr, truncada a execStderrMaxLen
+func execStderrSummary(stderr string) string {
+	for _, line := range strings.Split(stderr, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if len(line) > execStderrMaxLen {
+			line = strings.ToValidUTF8(line[:execStderrMaxLen], "") + "..."
+		}
+		return line
+	}
+	return ""
+}
+
+// tokenFromExecOutput extrae el token de la salida de un comando de autenticación
+func tokenFromExecOutput(output []byte) (string, error) {
+	output = bytes.TrimSpace(output)
+	if len(output) == 0 {
+		return "", fmt.Errorf("el comando no escribió ningún token")
+	}
+
+	if output[0] != '{' {
+		return string(output), nil
+	}
+
+	var credential struct {
+		Status struct {
+			Token string `json:"token"`
+		} `json:"status"`
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.Unmarshal(output, &credential); err != nil {
+		return "", fmt.Errorf("error parseando JSON: %w", err)
+	}
+
+	for _, token := range []string{credential.Status.Token, credential.Token, credential.AccessToken} {
+		if token != "" {
+			return token, nil
+		}
+	}
+
+	return "", fmt.Errorf("el JSON no contiene status.token, token ni access_token")
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTokenFromExecOutput(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    string
+		wantErr bool
+	}{
+		{name: "texto plano", output: "eyJhbGciOi.payload.firma\n", want: "eyJhbGciOi.payload.firma"},
+		{name: "ExecCredential", output: `{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"abc"}}`, want: "abc"},
+		{name: "token", output: ` {"token":"def"} `, want: "def"},
+		{name: "access_token", output: `{"access_token":"ghi","expires_in":300}`, want: "ghi"},
+		{name: "status.token tiene prioridad", output: `{"status":{"token":"a"},"token":"b","access_token":"c"}`, want: "a"},
+		{name: "salida vacía", output: " \n", wantErr: true},
+		{name: "JSON sin token", output: `{"expires_in":300}`, wantErr: true},
+		{name: "JSON no válido", output: `{"token":`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tokenFromExecOutput([]byte(tt.output))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tokenFromExecOutput error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("tokenFromExecOutput = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExecStderrSummary(t *testing.T) {
+	long := strings.Repeat("x", execStderrMaxLen+50)
+
+	tests := []struct {
+		name   string
+		stderr string
+		want   string
+	}{
+		{name: "vacío", stderr: "", want: ""},
+		{name: "una línea", stderr: "error: credenciales no válidas\n", want: "error: credenciales no válidas"},
+		{name: "varias líneas", stderr: "\n  primera\nsegunda con secreto=1234\n", want: "primera"},
+		{name: "línea larga", stderr: long, want: long[:execStderrMaxLen] + "..."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := execStderrSummary(tt.stderr); got != tt.want {
+				t.Errorf("execStderrSummary = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignInOIDCClientCredentialsRequiresHTTPS(t *testing.T) {
+	for _, tokenURL := range []string{"http://sso.example.org/token", "sso.example.org/token", "https://"} {
+		t.Run(tokenURL, func(t *testing.T) {
+			c := &Client{}
+			err := c.signInOIDCClientCredentials(AuthConfig{OIDCTokenURL: tokenURL, OIDCClientID: "id", OIDCClientSecret: "secret"})
+			if err == nil || !strings.Contains(err.Error(), "https") {
+				t.Errorf("signInOIDCClientCredentials(%q) error = %v, want error de https", tokenURL, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Category representa una categoría en Isard VDI
+type Category struct {
+	ID            string      `json:"id"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description"`
+	Frontend      bool        `json:"frontend"`
+	CustomURLName string      `json:"custom_url_name"`
+	Ephemeral     interface{} `json:"ephimeral"` // false u objeto {minutes, action}; la API usa la grafía "ephimeral"
+	Quota         interface{} `json:"quota"`     // false (sin cuota propia) u objeto con la cuota
+	Limits        interface{} `json:"limits"`    // false (sin límites) u objeto con los límites
+}
+
+// GetCategories obtiene la lista de categorías
+func (c *Client) GetCategories() ([]Category, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/categories", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo categorías: %w", err)
+	}
+
+	var categories []Category
+	if err := json.Unmarshal(body, &categories); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return categories, nil
+}
+
+// GetCategory obtiene la información de una categoría
+func (c *Client) GetCategory(categoryID string) (*Category, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/category/%s", c.HostURL, categoryID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("category not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo categoría (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var category Category
+	if err := json.Unmarshal(body, &category); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &category, nil
+}
+
+// CreateCategory crea una nueva categoría. Isard crea además su grupo por defecto.
+func (c *Client) CreateCategory(category *Category) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/category", c.HostURL)
+
+	// Construir el payload
+	payload := map[string]interface{}{
+		"name":            category.Name,
+		"description":     category.Description,
+		"frontend":        category.Frontend,
+		"custom_url_name": category.CustomURLName,
+		"quota":           category.Quota,
+		"limits":          category.Limits,
+	}
+
+	if category.Ephemeral != nil {
+		payload["ephimeral"] = category.Ephemeral
+	} else {
+		payload["ephimeral"] = false
+	}
+
+	if category.Quota == nil {
+		payload["quota"] = false
+	}
+
+	if category.Limits == nil {
+		payload["limits"] = false
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando categoría (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	categoryID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return categoryID, nil
+}
+
+// UpdateCategory actualiza una categoría existente
+func (c *Client) UpdateCategory(categoryID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/category/%s", c.HostURL, categoryID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando categoría (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteCategory elimina una categoría
+func (c *Client) DeleteCategory(categoryID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/category/%s", c.HostURL, categoryID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando categoría (status %d): %s", res.StatusCode, string(body))
+}
@@ -39,22 +39,22 @@ func NewClient(host, token string) *Client {
 }
 
 // SignIn performs the authentication flow
-func (c *Client) SignIn(authMethod, categoryID, username, password string) error {
-	if authMethod == "token" {
+func (c *Client) SignIn(auth AuthConfig) error {
+	if auth.Method == "token" {
 		// Cuando usamos token, simplemente lo usamos directamente sin hacer llamadas adicionales
 		// El token ya está almacenado en c.Token desde NewClient
 		return nil
 	}
 
-	if authMethod == "form" {
+	if auth.Method == "form" {
 		// Construir URL con query params
-		reqURL := fmt.Sprintf("https://%s%s?provider=form&category_id=%s", c.HostURL, constants.LoginPath, categoryID)
+		reqURL := fmt.Sprintf("https://%s%s?provider=form&category_id=%s", c.HostURL, constants.LoginPath, auth.CategoryID)
 
 		// Multipart form data
 		body := &bytes.Buffer{}
 		writer := multipart.NewWriter(body)
-		_ = writer.WriteField("username", username)
-		_ = writer.WriteField("password", password)
+		_ = writer.WriteField("username", auth.Username)
+		_ = writer.WriteField("password", auth.Password)
 		err := writer.Close()
 		if err != nil {
 			return err
@@ -72,6 +72,14 @@ func (c *Client) SignIn(authMethod, categoryID, username, password string) error
 		return c.executeAuthRequest(req)
 	}
 
+	if auth.Method == "oidc_client_credentials" {
+		return c.signInOIDCClientCredentials(auth)
+	}
+
+	if auth.Method == "exec" {
+		return c.signInExec(auth)
+	}
+
 	return nil
 }
 
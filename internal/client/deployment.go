@@ -38,6 +38,16 @@ type DeploymentInfo struct {
 	VisibleDesktops int                    `json:"visibleDesktops"`
 	StartedDesktops int                    `json:"startedDesktops"`
 	CreatingDesktops int                   `json:"creatingDesktops"`
+	Desktops        []DeploymentDesktop    `json:"desktops"`
+}
+
+// DeploymentDesktop representa un desktop creado por un deployment
+type DeploymentDesktop struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	User     string `json:"user"`
+	Username string `json:"username"`
+	Status   string `json:"status"`
 }
 
 // CreateDeployment crea un nuevo deployment
@@ -54,6 +64,8 @@ func (c *Client) CreateDeployment(
 	guestProperties map[string]interface{},
 	image map[string]interface{},
 	userPermissions []string,
+	reservables *Reservables,
+	qosDiskID string,
 ) (string, error) {
 	reqURL := fmt.Sprintf("https://%s/api/v3/deployments", c.HostURL)
 
@@ -138,8 +150,21 @@ func (c *Client) CreateDeployment(
 		hardware["interfaces"] = []string{"default", "wireguard"}
 	}
 	
-	// reservables: siempre ["None"] para que funcione correctamente
-	hardware["reservables"] = map[string]interface{}{"vgpus": []string{"None"}}
+	// reservables: usar el perfil especificado, el del template o ninguno
+	if reservables != nil {
+		hardware["reservables"] = reservables
+	} else if templateReservables := reservablesFromAPI(templateHardware["reservables"]); templateReservables.VGPU() != "" {
+		hardware["reservables"] = templateReservables
+	} else {
+		hardware["reservables"] = NoReservables()
+	}
+
+	// qos_disk_id: usar el perfil especificado o el del template
+	if qosDiskID != "" {
+		hardware["qos_disk_id"] = qosDiskID
+	} else if templateQoSDisk, ok := templateHardware["qos_disk_id"]; ok {
+		hardware["qos_disk_id"] = templateQoSDisk
+	}
 	payload["hardware"] = hardware
 	
 	// guest_properties: combinar valores del template con los especificados
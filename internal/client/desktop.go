@@ -8,29 +8,68 @@ import (
 	"net/http"
 )
 
+// Estados de un desktop
+const (
+	DesktopStatusStarted = "Started"
+	DesktopStatusStopped = "Stopped"
+	DesktopStatusFailed  = "Failed"
+)
+
 // Desktop representa la estructura de un desktop en la API
 type Desktop struct {
-	ID          string  `json:"id"`
-	Name        string  `json:"name"`
-	Description string  `json:"description"`
-	TemplateID  string  `json:"template_id"`
-	VCPUs       int64   `json:"vcpus,omitempty"`
-	Memory      float64 `json:"memory,omitempty"`
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	TemplateID  string                 `json:"template_id"`
+	Status      string                 `json:"status"`
+	VCPUs       int64                  `json:"vcpus,omitempty"`
+	Memory      float64                `json:"memory,omitempty"`
+	GuestProps  map[string]interface{} `json:"guest_properties,omitempty"`
+	Hardware    HardwareSpec           `json:"-"` // Resto del hardware leído de domain/info
 }
 
 // HardwareSpec especifica el hardware personalizado para un desktop
 type HardwareSpec struct {
-	VCPUs      *int64   `json:"vcpus,omitempty"`
-	Memory     *float64 `json:"memory,omitempty"`
-	DiskBus    string   `json:"disk_bus,omitempty"`
-	BootOrder  []string `json:"boot_order,omitempty"`
-	Graphics   []string `json:"graphics,omitempty"`
-	Videos     []string `json:"videos,omitempty"`
-	Interfaces []string `json:"interfaces,omitempty"`
+	VCPUs       *int64       `json:"vcpus,omitempty"`
+	Memory      *float64     `json:"memory,omitempty"`
+	DiskBus     string       `json:"disk_bus,omitempty"`
+	BootOrder   []string     `json:"boot_order,omitempty"`
+	Graphics    []string     `json:"graphics,omitempty"`
+	Videos      []string     `json:"videos,omitempty"`
+	Interfaces  []string     `json:"interfaces,omitempty"`
+	Isos        []MediaRef   `json:"isos,omitempty"`
+	Floppies    []MediaRef   `json:"floppies,omitempty"`
+	Reservables *Reservables `json:"reservables,omitempty"`
+	QoSDiskID   string       `json:"qos_disk_id,omitempty"`
+}
+
+// MediaRef referencia un medio (ISO o floppy) adjunto a un desktop
+type MediaRef struct {
+	ID string `json:"id"`
+}
+
+// MediaRefs convierte una lista de IDs de medios en referencias
+func MediaRefs(ids []string) []MediaRef {
+	if ids == nil {
+		return nil
+	}
+	refs := make([]MediaRef, len(ids))
+	for i, id := range ids {
+		refs[i] = MediaRef{ID: id}
+	}
+	return refs
+}
+
+// IsEmpty indica si no se ha especificado ningún valor de hardware
+func (h *HardwareSpec) IsEmpty() bool {
+	return h == nil || (h.VCPUs == nil && h.Memory == nil && h.DiskBus == "" &&
+		h.BootOrder == nil && h.Graphics == nil && h.Videos == nil &&
+		h.Interfaces == nil && h.Isos == nil && h.Floppies == nil &&
+		h.Reservables == nil && h.QoSDiskID == "")
 }
 
 // CreatePersistentDesktop crea un nuevo persistent desktop
-func (c *Client) CreatePersistentDesktop(name, description, templateID string, vcpus *int64, memory *float64, interfaces []string) (string, error) {
+func (c *Client) CreatePersistentDesktop(name, description, templateID string, hardware *HardwareSpec, guestProperties map[string]interface{}) (string, error) {
 	reqURL := fmt.Sprintf("https://%s/api/v3/persistent_desktop", c.HostURL)
 
 	// Construir el payload
@@ -44,20 +83,15 @@ func (c *Client) CreatePersistentDesktop(name, description, templateID string, v
 	}
 
 	// Agregar hardware personalizado si se especifica
-	if vcpus != nil || memory != nil || len(interfaces) > 0 {
-		hardware := make(map[string]interface{})
-		if vcpus != nil {
-			hardware["vcpus"] = *vcpus
-		}
-		if memory != nil {
-			hardware["memory"] = *memory
-		}
-		if len(interfaces) > 0 {
-			hardware["interfaces"] = interfaces
-		}
+	if !hardware.IsEmpty() {
 		payload["hardware"] = hardware
 	}
 
+	// Agregar guest_properties (viewers, fullscreen, credenciales) si se especifican
+	if len(guestProperties) > 0 {
+		payload["guest_properties"] = guestProperties
+	}
+
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return "", fmt.Errorf("error codificando JSON: %w", err)
@@ -100,6 +134,126 @@ func (c *Client) CreatePersistentDesktop(name, description, templateID string, v
 	return desktopID, nil
 }
 
+// CreateDesktopFromMedia crea un desktop persistente con un disco vacío de diskSize GB
+// que arranca desde el medio indicado, usando el perfil de instalación osProfile
+func (c *Client) CreateDesktopFromMedia(name, description, mediaID string, diskSize int64, osProfile string, hardware *HardwareSpec, guestProperties map[string]interface{}) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/desktop/from/media", c.HostURL)
+
+	// Construir el payload
+	payload := map[string]interface{}{
+		"name":      name,
+		"media_id":  mediaID,
+		"disk_size": diskSize,
+		"xml_id":    osProfile,
+	}
+
+	if description != "" {
+		payload["description"] = description
+	}
+
+	// Agregar hardware personalizado si se especifica
+	if !hardware.IsEmpty() {
+		payload["hardware"] = hardware
+	}
+
+	// Agregar guest_properties (viewers, fullscreen, credenciales) si se especifican
+	if len(guestProperties) > 0 {
+		payload["guest_properties"] = guestProperties
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando desktop desde medio (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	desktopID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return desktopID, nil
+}
+
+// CreateVolatileDesktop crea un desktop no persistente a partir de un template.
+// Isard lo arranca al crearlo y lo elimina cuando se detiene.
+func (c *Client) CreateVolatileDesktop(templateID string) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/desktop", c.HostURL)
+
+	payload := map[string]interface{}{
+		"template_id": templateID,
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando desktop volátil (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	desktopID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return desktopID, nil
+}
+
 // GetDesktop obtiene la información de un desktop
 func (c *Client) GetDesktop(desktopID string) (*Desktop, error) {
 	reqURL := fmt.Sprintf("https://%s/api/v3/domain/info/%s", c.HostURL, desktopID)
@@ -147,6 +301,9 @@ func (c *Client) GetDesktop(desktopID string) (*Desktop, error) {
 	if desc, ok := response["description"].(string); ok {
 		desktop.Description = desc
 	}
+	if status, ok := response["status"].(string); ok {
+		desktop.Status = status
+	}
 	if createDict, ok := response["create_dict"].(map[string]interface{}); ok {
 		if origin, ok := createDict["origin"].(string); ok {
 			desktop.TemplateID = origin
@@ -161,11 +318,91 @@ func (c *Client) GetDesktop(desktopID string) (*Desktop, error) {
 		if memory, ok := hardware["memory"].(float64); ok {
 			desktop.Memory = memory
 		}
+		if diskBus, ok := hardware["disk_bus"].(string); ok {
+			desktop.Hardware.DiskBus = diskBus
+		}
+		desktop.Hardware.BootOrder = idList(hardware["boot_order"])
+		desktop.Hardware.Graphics = idList(hardware["graphics"])
+		desktop.Hardware.Videos = idList(hardware["videos"])
+		desktop.Hardware.Interfaces = idList(hardware["interfaces"])
+		desktop.Hardware.Isos = MediaRefs(idList(hardware["isos"]))
+		desktop.Hardware.Floppies = MediaRefs(idList(hardware["floppies"]))
+		desktop.Hardware.Reservables = reservablesFromAPI(hardware["reservables"])
+		// qos_disk_id es false si el desktop no tiene perfil de QoS de disco
+		if qosDiskID, ok := hardware["qos_disk_id"].(string); ok {
+			desktop.Hardware.QoSDiskID = qosDiskID
+		}
+	}
+
+	// Leer las guest_properties
+	if guestProps, ok := response["guest_properties"].(map[string]interface{}); ok {
+		desktop.GuestProps = guestProps
 	}
 
 	return desktop, nil
 }
 
+// idList extrae una lista de IDs de un valor de hardware, que la API devuelve
+// como lista de strings o como lista de objetos con campo "id"
+func idList(value interface{}) []string {
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		switch v := item.(type) {
+		case string:
+			ids = append(ids, v)
+		case map[string]interface{}:
+			if id, ok := v["id"].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+	return ids
+}
+
+// UpdateDesktop actualiza un desktop existente
+func (c *Client) UpdateDesktop(desktopID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/domain/%s", c.HostURL, desktopID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("desktop not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando desktop (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 // DeleteDesktop deletes a desktop by its ID
 func (c *Client) DeleteDesktop(desktopID string) error {
 	reqURL := fmt.Sprintf("https://%s/api/v3/desktop/%s/true", c.HostURL, desktopID)
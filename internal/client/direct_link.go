@@ -0,0 +1,104 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// DirectLinkURL construye la URL pública del enlace directo (jumper URL) a partir de su token
+func (c *Client) DirectLinkURL(token string) string {
+	if token == "" {
+		return ""
+	}
+	return fmt.Sprintf("https://%s/vw/%s", c.HostURL, token)
+}
+
+// GetDirectLink obtiene el token del enlace directo de un desktop ("" si está deshabilitado)
+func (c *Client) GetDirectLink(desktopID string) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/desktop/jumperurl/%s", c.HostURL, desktopID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("desktop not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error obteniendo enlace directo (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return parseDirectLink(body)
+}
+
+// SetDirectLink habilita (generando un token nuevo) o deshabilita el enlace directo de un desktop.
+// Devuelve el token generado, o "" si se ha deshabilitado.
+func (c *Client) SetDirectLink(desktopID string, enabled bool) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/desktop/jumperurl_reset/%s", c.HostURL, desktopID)
+
+	jsonData, err := json.Marshal(map[string]interface{}{
+		"disabled": !enabled,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("desktop not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("error actualizando enlace directo (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return parseDirectLink(body)
+}
+
+// parseDirectLink extrae el token de la respuesta; la API devuelve false si el enlace está deshabilitado
+func parseDirectLink(body []byte) (string, error) {
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	token, _ := response["jumperurl"].(string)
+	return token, nil
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,12 +10,14 @@ import (
 
 // Group representa un grupo en Isard VDI
 type Group struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	Description    string   `json:"description"`
-	ParentCategory string   `json:"parent_category"`
-	LinkedGroups   []string `json:"linked_groups"`
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	Description    string                 `json:"description"`
+	ParentCategory string                 `json:"parent_category"`
+	LinkedGroups   []string               `json:"linked_groups"`
 	Enrollment     map[string]interface{} `json:"enrollment,omitempty"`
+	Quota          interface{}            `json:"quota"`  // false (hereda de la categoría) u objeto con la cuota
+	Limits         interface{}            `json:"limits"` // false (hereda de la categoría) u objeto con los límites
 }
 
 // GetGroups obtiene la lista de grupos
@@ -50,3 +53,228 @@ func (c *Client) GetGroups() ([]Group, error) {
 
 	return groups, nil
 }
+
+// GetGroup obtiene la información de un grupo
+func (c *Client) GetGroup(groupID string) (*Group, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/group/%s", c.HostURL, groupID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("group not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo grupo (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var group Group
+	if err := json.Unmarshal(body, &group); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &group, nil
+}
+
+// CreateGroup crea un nuevo grupo
+func (c *Client) CreateGroup(group *Group) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/group", c.HostURL)
+
+	// Construir el payload
+	payload := map[string]interface{}{
+		"name":            group.Name,
+		"description":     group.Description,
+		"parent_category": group.ParentCategory,
+		"linked_groups":   group.LinkedGroups,
+		"quota":           group.Quota,
+		"limits":          group.Limits,
+	}
+
+	if group.LinkedGroups == nil {
+		payload["linked_groups"] = []string{}
+	}
+
+	if group.Quota == nil {
+		payload["quota"] = false
+	}
+
+	if group.Limits == nil {
+		payload["limits"] = false
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando grupo (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	groupID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return groupID, nil
+}
+
+// UpdateGroup actualiza un grupo existente
+func (c *Client) UpdateGroup(groupID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/group/%s", c.HostURL, groupID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando grupo (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteGroup elimina un grupo
+func (c *Client) DeleteGroup(groupID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/group/%s", c.HostURL, groupID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando grupo (status %d): %s", res.StatusCode, string(body))
+}
+
+// SetGroupEnrollment genera un nuevo código de inscripción para un rol del grupo
+// (action "reset") o lo deshabilita (action "disable"). Devuelve el código generado.
+func (c *Client) SetGroupEnrollment(groupID, role, action string) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/group/enrollment", c.HostURL)
+
+	payload := map[string]interface{}{
+		"id":     groupID,
+		"role":   role,
+		"action": action,
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return "", fmt.Errorf("error actualizando código de inscripción: %w", err)
+	}
+
+	if action != "reset" {
+		return "", nil
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	code, ok := response["code"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el código en la respuesta: %s", string(body))
+	}
+
+	return code, nil
+}
+
+// EnrollmentCode devuelve el código de inscripción de un rol ("" si está deshabilitado)
+func (g *Group) EnrollmentCode(role string) string {
+	code, _ := g.Enrollment[role].(string)
+	return code
+}
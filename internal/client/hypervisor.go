@@ -0,0 +1,259 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+// Estados de un hipervisor
+const (
+	HypervisorStatusOnline  = "Online"
+	HypervisorStatusOffline = "Offline"
+	HypervisorStatusError   = "Error"
+)
+
+// Hypervisor representa un hipervisor de Isard VDI
+type Hypervisor struct {
+	ID                string
+	Hostname          string
+	Port              int64
+	User              string
+	Enabled           bool
+	OnlyForced        bool
+	MinFreeMemGB      int64
+	GPUEnabled        bool
+	ViewerHostname    string
+	ViewerNATHostname string
+	ViewerNATOffset   int64
+	DiskOperations    bool
+	Virtualization    bool
+
+	// Valores de solo lectura
+	Status            string
+	DesktopsStarted   int64
+	CPUPercent        float64
+	MemoryUsedPercent float64
+}
+
+// toAPI construye el payload de creación/actualización del hipervisor
+func (h *Hypervisor) toAPI() map[string]interface{} {
+	return map[string]interface{}{
+		"hostname":            h.Hostname,
+		"port":                strconv.FormatInt(h.Port, 10),
+		"user":                h.User,
+		"enabled":             h.Enabled,
+		"only_forced":         h.OnlyForced,
+		"min_free_mem_gb":     h.MinFreeMemGB,
+		"nvidia_enabled":      h.GPUEnabled,
+		"viewer_hostname":     h.ViewerHostname,
+		"viewer_nat_hostname": h.ViewerNATHostname,
+		"viewer_nat_offset":   h.ViewerNATOffset,
+		"capabilities": map[string]interface{}{
+			"disk_operations": h.DiskOperations,
+			"hypervisor":      h.Virtualization,
+		},
+	}
+}
+
+// hypervisorFromAPI convierte un hipervisor de la API, cuyos campos numéricos
+// pueden llegar como números o como cadenas
+func hypervisorFromAPI(data map[string]interface{}) Hypervisor {
+	h := Hypervisor{
+		ID:                stringValue(data["id"]),
+		Hostname:          stringValue(data["hostname"]),
+		Port:              int64Value(data["port"]),
+		User:              stringValue(data["user"]),
+		Enabled:           data["enabled"] == true,
+		OnlyForced:        data["only_forced"] == true,
+		MinFreeMemGB:      int64Value(data["min_free_mem_gb"]),
+		GPUEnabled:        data["nvidia_enabled"] == true,
+		ViewerHostname:    stringValue(data["viewer_hostname"]),
+		ViewerNATHostname: stringValue(data["viewer_nat_hostname"]),
+		ViewerNATOffset:   int64Value(data["viewer_nat_offset"]),
+		Status:            stringValue(data["status"]),
+		DesktopsStarted:   int64Value(data["desktops_started"]),
+	}
+
+	if capabilities, ok := data["capabilities"].(map[string]interface{}); ok {
+		h.DiskOperations = capabilities["disk_operations"] == true
+		h.Virtualization = capabilities["hypervisor"] == true
+	}
+
+	// Carga: uso de CPU y memoria de las últimas estadísticas recibidas
+	if stats, ok := data["stats"].(map[string]interface{}); ok {
+		if cpu, ok := stats["cpu_current"].(map[string]interface{}); ok {
+			h.CPUPercent = float64Value(cpu["used"])
+		}
+		if mem, ok := stats["mem_stats"].(map[string]interface{}); ok {
+			total := float64Value(mem["total"])
+			available := float64Value(mem["available"])
+			if total > 0 {
+				h.MemoryUsedPercent = (total - available) * 100 / total
+			}
+		}
+	}
+
+	return h
+}
+
+// CreateHypervisor añade un hipervisor con el ID indicado
+func (c *Client) CreateHypervisor(hypervisorID string, hypervisor *Hypervisor) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/hypervisor", c.HostURL)
+
+	payload := hypervisor.toAPI()
+	payload["hyper_id"] = hypervisorID
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return fmt.Errorf("error creando hipervisor (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// ListHypervisors obtiene la lista de hipervisores con su estado y carga
+func (c *Client) ListHypervisors() ([]Hypervisor, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/hypervisors", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando petición: %w", err)
+	}
+
+	var response []map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	hypervisors := make([]Hypervisor, len(response))
+	for i, data := range response {
+		hypervisors[i] = hypervisorFromAPI(data)
+	}
+
+	return hypervisors, nil
+}
+
+// GetHypervisor obtiene un hipervisor por su ID
+func (c *Client) GetHypervisor(hypervisorID string) (*Hypervisor, error) {
+	hypervisors, err := c.ListHypervisors()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range hypervisors {
+		if hypervisors[i].ID == hypervisorID {
+			return &hypervisors[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("hypervisor not found")
+}
+
+// UpdateHypervisor actualiza los campos indicados de un hipervisor
+func (c *Client) UpdateHypervisor(hypervisorID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/hypervisor/%s", c.HostURL, hypervisorID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("hypervisor not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando hipervisor (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// UpdateHypervisorSpec envía la configuración completa de un hipervisor
+func (c *Client) UpdateHypervisorSpec(hypervisorID string, hypervisor *Hypervisor) error {
+	return c.UpdateHypervisor(hypervisorID, hypervisor.toAPI())
+}
+
+// DeleteHypervisor elimina un hipervisor
+func (c *Client) DeleteHypervisor(hypervisorID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/hypervisor/%s", c.HostURL, hypervisorID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando hipervisor (status %d): %s", res.StatusCode, string(body))
+}
@@ -0,0 +1,49 @@
+package client
+
+import (
+	"strconv"
+	"time"
+)
+
+// Funciones auxiliares para leer valores de las respuestas JSON de la API,
+// que en algunas versiones de Isard devuelven números como cadenas.
+
+// stringValue devuelve el valor si es una cadena ("" en otro caso)
+func stringValue(value interface{}) string {
+	s, _ := value.(string)
+	return s
+}
+
+// float64Value convierte un número JSON o una cadena numérica (0 si no es posible)
+func float64Value(value interface{}) float64 {
+	switch v := value.(type) {
+	case float64:
+		return v
+	case string:
+		f, _ := strconv.ParseFloat(v, 64)
+		return f
+	}
+	return 0
+}
+
+// int64Value convierte un número JSON o una cadena numérica a entero (0 si no es posible)
+func int64Value(value interface{}) int64 {
+	return int64(float64Value(value))
+}
+
+// timestampValue convierte un timestamp Unix o una cadena de fecha a RFC 3339 ("" si no hay valor)
+func timestampValue(value interface{}) string {
+	switch v := value.(type) {
+	case float64:
+		if v <= 0 {
+			return ""
+		}
+		return time.Unix(int64(v), 0).UTC().Format(time.RFC3339)
+	case string:
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+		return v
+	}
+	return ""
+}
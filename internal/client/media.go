@@ -0,0 +1,217 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Estados de descarga de un medio
+const (
+	MediaStatusDownloaded = "Downloaded"
+	MediaStatusFailed     = "DownloadFailed"
+)
+
+// Media representa un medio (ISO o floppy) en Isard VDI
+type Media struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	URL         string                 `json:"url-web"`
+	Kind        string                 `json:"kind"`
+	Status      string                 `json:"status"`
+	Allowed     map[string]interface{} `json:"allowed"`
+	User        string                 `json:"user"`
+	Category    string                 `json:"category"`
+	Group       string                 `json:"group"`
+}
+
+// CreateMedia registra un medio para que Isard lo descargue desde la URL indicada
+func (c *Client) CreateMedia(name, description, url, kind string, allowed map[string]interface{}) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/media", c.HostURL)
+
+	// Construir el payload
+	payload := map[string]interface{}{
+		"name":              name,
+		"description":       description,
+		"url":               url,
+		"kind":              kind,
+		"hypervisors_pools": []string{"default"},
+	}
+
+	if allowed != nil {
+		payload["allowed"] = allowed
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando medio (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	mediaID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return mediaID, nil
+}
+
+// GetMedia obtiene la información de un medio
+func (c *Client) GetMedia(mediaID string) (*Media, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/media/%s", c.HostURL, mediaID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("media not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo medio (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var media Media
+	if err := json.Unmarshal(body, &media); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &media, nil
+}
+
+// ListMedia obtiene la lista de medios accesibles para el usuario
+func (c *Client) ListMedia() ([]Media, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/media", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo medios: %w", err)
+	}
+
+	var media []Media
+	if err := json.Unmarshal(body, &media); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return media, nil
+}
+
+// UpdateMedia actualiza un medio existente
+func (c *Client) UpdateMedia(mediaID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/media/%s", c.HostURL, mediaID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando medio (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteMedia elimina un medio
+func (c *Client) DeleteMedia(mediaID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/media/%s", c.HostURL, mediaID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando medio (status %d): %s", res.StatusCode, string(body))
+}
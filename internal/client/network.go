@@ -129,6 +129,11 @@ func (c *Client) GetNetwork(networkID string) (*Network, error) {
 		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
 	}
 
+	return networkFromRaw(rawNetwork), nil
+}
+
+// networkFromRaw construye un Network a partir de la respuesta decodificada con UseNumber
+func networkFromRaw(rawNetwork map[string]interface{}) *Network {
 	network := &Network{}
 	
 	// Parsear campos uno por uno
@@ -147,6 +152,20 @@ func (c *Client) GetNetwork(networkID string) (*Network, error) {
 	if qosID, ok := rawNetwork["qos_id"].(string); ok {
 		network.QoSID = qosID
 	}
+	if allowed, ok := rawNetwork["allowed"].(map[string]interface{}); ok {
+		network.Allowed = allowed
+	}
+	if user, ok := rawNetwork["user"].(string); ok {
+		network.User = user
+	}
+	if group, ok := rawNetwork["group"].(string); ok {
+		network.Group = group
+	}
+	if category, ok := rawNetwork["category"].(string); ok {
+		network.Category = category
+	}
+	network.Created = rawToString(rawNetwork["created"])
+	network.Modified = rawToString(rawNetwork["modified"])
 	
 	// Parsear metadata_id como json.Number para manejar valores grandes
 	// Convertir a string sin notación científica
@@ -164,7 +183,7 @@ func (c *Client) GetNetwork(networkID string) (*Network, error) {
 		}
 	}
 
-	return network, nil
+	return network
 }
 
 // UpdateNetwork actualiza una red existente
@@ -251,3 +270,24 @@ func (c *Client) DeleteNetwork(networkID string) error {
 
 	return fmt.Errorf("error eliminando red (status %d): %s", res.StatusCode, string(body))
 }
+
+// rawToString convierte un valor decodificado con UseNumber a string.
+// Las fechas pueden llegar como texto o como timestamp numérico.
+func rawToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	case map[string]interface{}:
+		// Formato de RethinkDB: {"$reql_type$": "TIME", "epoch_time": ...}
+		if epoch, ok := v["epoch_time"].(json.Number); ok {
+			return epoch.String()
+		}
+		return ""
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
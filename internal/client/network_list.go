@@ -0,0 +1,42 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListNetworks obtiene la lista de redes de usuario visibles
+func (c *Client) ListNetworks() ([]Network, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/user/networks", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando petición: %w", err)
+	}
+
+	// Decodificar con UseNumber para no perder precisión en metadata_id
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var rawNetworks []map[string]interface{}
+	if err := decoder.Decode(&rawNetworks); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	networks := make([]Network, 0, len(rawNetworks))
+	for _, rawNetwork := range rawNetworks {
+		networks = append(networks, *networkFromRaw(rawNetwork))
+	}
+
+	return networks, nil
+}
@@ -0,0 +1,248 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// QoSDiskUnlimited es el perfil de QoS de disco sin límites que Isard usa por defecto
+const QoSDiskUnlimited = "unlimited"
+
+// QoSDisk representa la estructura de un QoS de disco en la API
+type QoSDisk struct {
+	ID          string                 `json:"id"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	IOTune      map[string]interface{} `json:"iotune,omitempty"`
+}
+
+// CreateQoSDisk crea un nuevo QoS de disco con el ID indicado y devuelve el ID que
+// asigna el servidor (el indicado si la respuesta no incluye ninguno)
+func (c *Client) CreateQoSDisk(qosID, name, description string, iotune map[string]interface{}) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/add/qos_disk", c.HostURL)
+
+	payload := map[string]interface{}{
+		"id":     qosID,
+		"name":   name,
+		"iotune": iotune,
+	}
+	if description != "" {
+		payload["description"] = description
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando QoS de disco (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Usar el ID de la respuesta si la API lo devuelve
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err == nil {
+		if id, ok := response["id"].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+
+	return qosID, nil
+}
+
+// GetQoSDisk obtiene la información de un QoS de disco
+func (c *Client) GetQoSDisk(qosID string) (*QoSDisk, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/qos_disk", c.HostURL)
+
+	// Crear payload con el ID para obtener un item específico
+	jsonData, err := json.Marshal(map[string]interface{}{"id": qosID})
+	if err != nil {
+		return nil, fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("qos_disk not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo QoS de disco (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var qos QoSDisk
+	if err := json.Unmarshal(body, &qos); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &qos, nil
+}
+
+// ListQoSDisks obtiene la lista de todos los perfiles QoS de disco
+func (c *Client) ListQoSDisks() ([]QoSDisk, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/qos_disk", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando petición: %w", err)
+	}
+
+	var qosDisks []QoSDisk
+	if err := json.Unmarshal(body, &qosDisks); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return qosDisks, nil
+}
+
+// FindQoSDiskByName busca un QoS de disco por su nombre exacto.
+// Devuelve error si no existe o si hay varios con el mismo nombre.
+func (c *Client) FindQoSDiskByName(name string) (*QoSDisk, error) {
+	qosDisks, err := c.ListQoSDisks()
+	if err != nil {
+		return nil, err
+	}
+
+	var found *QoSDisk
+	for i := range qosDisks {
+		if qosDisks[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("hay varios QoS de disco con el nombre %q (IDs %s y %s)", name, found.ID, qosDisks[i].ID)
+		}
+		found = &qosDisks[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("qos_disk not found")
+	}
+
+	return found, nil
+}
+
+// UpdateQoSDisk actualiza un QoS de disco existente. iotune se envía completo si no es nil.
+func (c *Client) UpdateQoSDisk(qosID string, name, description *string, iotune map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/update/qos_disk", c.HostURL)
+
+	payload := map[string]interface{}{
+		"id": qosID,
+	}
+	if name != nil {
+		payload["name"] = *name
+	}
+	if description != nil {
+		payload["description"] = *description
+	}
+	if iotune != nil {
+		payload["iotune"] = iotune
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando QoS de disco (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteQoSDisk elimina un QoS de disco
+func (c *Client) DeleteQoSDisk(qosID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/qos_disk/%s", c.HostURL, qosID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando QoS de disco (status %d): %s", res.StatusCode, string(body))
+}
@@ -16,12 +16,14 @@ type QoSNet struct {
 	Bandwidth   map[string]interface{} `json:"bandwidth,omitempty"`
 }
 
-// CreateQoSNet crea un nuevo QoS de red
-func (c *Client) CreateQoSNet(name, description string, bandwidth map[string]interface{}) (string, error) {
+// CreateQoSNet crea un nuevo QoS de red con el ID indicado y devuelve el ID que
+// asigna el servidor (el indicado si la respuesta no incluye ninguno)
+func (c *Client) CreateQoSNet(qosID, name, description string, bandwidth map[string]interface{}) (string, error) {
 	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/add/qos_net", c.HostURL)
 
 	// Construir el payload
 	payload := map[string]interface{}{
+		"id":   qosID,
 		"name": name,
 	}
 	
@@ -61,15 +63,41 @@ func (c *Client) CreateQoSNet(name, description string, bandwidth map[string]int
 		return "", fmt.Errorf("error creando QoS de red (status %d): %s", res.StatusCode, string(body))
 	}
 
-	// La API devuelve el ID en el campo 'id' o podemos usar el nombre como ID
-	// Primero intentamos obtener el ID de la base de datos
-	qos, err := c.GetQoSNet(name)
+	// Usar el ID de la respuesta si la API lo devuelve
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err == nil {
+		if id, ok := response["id"].(string); ok && id != "" {
+			return id, nil
+		}
+	}
+
+	return qosID, nil
+}
+
+// FindQoSNetByName busca un QoS de red por su nombre exacto.
+// Devuelve error si no existe o si hay varios con el mismo nombre.
+func (c *Client) FindQoSNetByName(name string) (*QoSNet, error) {
+	qosNets, err := c.ListQoSNets()
 	if err != nil {
-		// Si no podemos obtenerlo, usamos el nombre como ID
-		return name, nil
+		return nil, err
+	}
+
+	var found *QoSNet
+	for i := range qosNets {
+		if qosNets[i].Name != name {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("hay varios QoS de red con el nombre %q (IDs %s y %s)", name, found.ID, qosNets[i].ID)
+		}
+		found = &qosNets[i]
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("qos_net not found")
 	}
 
-	return qos.ID, nil
+	return found, nil
 }
 
 // GetQoSNet obtiene la información de un QoS de red
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ListQoSNets obtiene la lista de todos los perfiles QoS de red
+func (c *Client) ListQoSNets() ([]QoSNet, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/table/qos_net", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando petición: %w", err)
+	}
+
+	var qosNets []QoSNet
+	if err := json.Unmarshal(body, &qosNets); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return qosNets, nil
+}
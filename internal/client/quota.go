@@ -0,0 +1,55 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// QuotaUsage representa la cuota aplicada a un usuario, grupo o categoría y su consumo actual
+type QuotaUsage struct {
+	Quota  interface{}            `json:"quota"`  // false (sin cuota) u objeto con la cuota aplicada
+	Limits interface{}            `json:"limits"` // false (sin límites) u objeto con los límites aplicados
+	Used   map[string]interface{} `json:"used"`   // consumo actual con las mismas claves que la cuota
+}
+
+// GetQuotaUsage obtiene la cuota aplicada y el consumo actual de un usuario, grupo o categoría.
+// kind debe ser "user", "group" o "category".
+func (c *Client) GetQuotaUsage(kind, targetID string) (*QuotaUsage, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/quota/%s/%s", c.HostURL, kind, targetID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%s not found", kind)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo cuota (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var usage QuotaUsage
+	if err := json.Unmarshal(body, &usage); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &usage, nil
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// ReservableNone es el valor que la API usa para indicar que no se reserva ningún recurso
+const ReservableNone = "None"
+
+// Reservables especifica los recursos reservables (perfiles de GPU) de un desktop
+type Reservables struct {
+	VGPUs []string `json:"vgpus"`
+}
+
+// NoReservables devuelve unos reservables que no reservan ningún recurso
+func NoReservables() *Reservables {
+	return &Reservables{VGPUs: []string{ReservableNone}}
+}
+
+// VGPU devuelve el perfil de GPU seleccionado, o "" si no hay ninguno
+func (r *Reservables) VGPU() string {
+	if r == nil {
+		return ""
+	}
+	for _, profile := range r.VGPUs {
+		if profile != "" && profile != ReservableNone {
+			return profile
+		}
+	}
+	return ""
+}
+
+// reservablesFromAPI convierte el campo "reservables" del hardware de la API
+func reservablesFromAPI(value interface{}) *Reservables {
+	reservables, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	return &Reservables{VGPUs: idList(reservables["vgpus"])}
+}
+
+// GPUProfile representa un perfil de vGPU disponible para reservar
+type GPUProfile struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Brand       string `json:"brand"`
+	Model       string `json:"model"`
+	Profile     string `json:"profile"`
+	Memory      int64  `json:"memory"`
+	Units       int64  `json:"units"`
+}
+
+// ListGPUProfiles obtiene los perfiles de vGPU que el usuario puede reservar
+func (c *Client) ListGPUProfiles() ([]GPUProfile, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/reservables/vgpus", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo perfiles de GPU: %w", err)
+	}
+
+	var profiles []GPUProfile
+	if err := json.Unmarshal(body, &profiles); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return profiles, nil
+}
+
+// Booking representa una reserva de los recursos de un desktop o deployment
+type Booking struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	ElementID   string `json:"element_id"`
+	ElementType string `json:"element_type"`
+	Start       string `json:"start"`
+	End         string `json:"end"`
+}
+
+// CreateBooking reserva los recursos de un desktop o deployment en una franja horaria
+func (c *Client) CreateBooking(booking *Booking) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/booking/event", c.HostURL)
+
+	jsonData, err := json.Marshal(booking)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando reserva (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	bookingID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return bookingID, nil
+}
+
+// GetBooking obtiene la información de una reserva
+func (c *Client) GetBooking(bookingID string) (*Booking, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/booking/event/%s", c.HostURL, bookingID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("booking not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo reserva (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var booking Booking
+	if err := json.Unmarshal(body, &booking); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &booking, nil
+}
+
+// UpdateBooking actualiza el título o la franja horaria de una reserva
+func (c *Client) UpdateBooking(bookingID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/booking/event/%s", c.HostURL, bookingID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando reserva (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteBooking cancela una reserva
+func (c *Client) DeleteBooking(bookingID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/booking/event/%s", c.HostURL, bookingID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando reserva (status %d): %s", res.StatusCode, string(body))
+}
@@ -0,0 +1,216 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// Tipos de ruta de un storage pool
+var StoragePoolPathKinds = []string{"desktop", "template", "media", "volatile"}
+
+// StoragePoolPath es una ruta de un storage pool, relativa a su punto de montaje,
+// con el peso que se usa para repartir los discos entre las rutas del mismo tipo
+type StoragePoolPath struct {
+	Path   string `json:"path"`
+	Weight int64  `json:"weight"`
+}
+
+// StoragePoolUsage es la ocupación del almacenamiento de un storage pool en bytes
+type StoragePoolUsage struct {
+	Size      float64 `json:"size"`
+	Used      float64 `json:"used"`
+	Available float64 `json:"available"`
+}
+
+// StoragePool representa la estructura de un storage pool en la API
+type StoragePool struct {
+	ID          string                       `json:"id,omitempty"`
+	Name        string                       `json:"name"`
+	Description string                       `json:"description"`
+	Mountpoint  string                       `json:"mountpoint"`
+	Paths       map[string][]StoragePoolPath `json:"paths"`
+	Categories  []string                     `json:"categories"`
+	Enabled     bool                         `json:"enabled"`
+	ReadOnly    bool                         `json:"read_only"`
+	Usage       *StoragePoolUsage            `json:"usage,omitempty"`
+}
+
+// CreateStoragePool crea un nuevo storage pool y devuelve el ID asignado por el servidor
+func (c *Client) CreateStoragePool(pool *StoragePool) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/storage_pool", c.HostURL)
+
+	jsonData, err := json.Marshal(pool)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando storage pool (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	id, ok := response["id"].(string)
+	if !ok || id == "" {
+		return "", fmt.Errorf("la respuesta no contiene el ID del storage pool: %s", string(body))
+	}
+
+	return id, nil
+}
+
+// GetStoragePool obtiene la información de un storage pool
+func (c *Client) GetStoragePool(poolID string) (*StoragePool, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/storage_pool/%s", c.HostURL, poolID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("storage_pool not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo storage pool (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var pool StoragePool
+	if err := json.Unmarshal(body, &pool); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &pool, nil
+}
+
+// ListStoragePools obtiene la lista de storage pools con su ocupación
+func (c *Client) ListStoragePools() ([]StoragePool, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/storage_pools", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando petición: %w", err)
+	}
+
+	var pools []StoragePool
+	if err := json.Unmarshal(body, &pools); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return pools, nil
+}
+
+// UpdateStoragePool envía la configuración completa de un storage pool
+func (c *Client) UpdateStoragePool(poolID string, pool *StoragePool) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/storage_pool/%s", c.HostURL, poolID)
+
+	jsonData, err := json.Marshal(pool)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("storage_pool not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando storage pool (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteStoragePool elimina un storage pool
+func (c *Client) DeleteStoragePool(poolID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/storage_pool/%s", c.HostURL, poolID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando storage pool (status %d): %s", res.StatusCode, string(body))
+}
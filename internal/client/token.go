@@ -0,0 +1,36 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TokenExpiry devuelve la caducidad (claim "exp") de un token JWT de Isard.
+// No valida la firma: solo sirve para saber hasta cuándo se puede usar el token.
+func TokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("el token no tiene formato JWT")
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error decodificando el payload del token: %w", err)
+	}
+
+	var claims struct {
+		Exp float64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("error parseando el payload del token: %w", err)
+	}
+
+	if claims.Exp == 0 {
+		return time.Time{}, fmt.Errorf("el token no tiene caducidad")
+	}
+
+	return time.Unix(int64(claims.Exp), 0).UTC(), nil
+}
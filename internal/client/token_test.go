@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+)
+
+// testJWT construye un token JWT sin firma válida con el payload indicado
+func testJWT(payload string) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	return header + "." + base64.RawURLEncoding.EncodeToString([]byte(payload)) + ".firma"
+}
+
+func TestTokenExpiry(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "exp entero", token: testJWT(`{"exp":1767225600}`), want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "exp decimal", token: testJWT(`{"exp":1767225600.7,"kid":"x"}`), want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "payload con padding", token: "a." + base64.URLEncoding.EncodeToString([]byte(`{"exp":1767225600}`)) + ".b", want: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "sin exp", token: testJWT(`{"sub":"admin"}`), wantErr: true},
+		{name: "payload no JSON", token: testJWT(`no es json`), wantErr: true},
+		{name: "payload no base64", token: "a.%%%.b", wantErr: true},
+		{name: "no es JWT", token: "token-opaco", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TokenExpiry(tt.token)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TokenExpiry error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !got.Equal(tt.want) {
+				t.Errorf("TokenExpiry = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
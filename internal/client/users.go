@@ -0,0 +1,226 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// User representa un usuario en Isard VDI
+type User struct {
+	ID              string      `json:"id"`
+	Provider        string      `json:"provider"`
+	Username        string      `json:"username"`
+	Name            string      `json:"name"`
+	Email           string      `json:"email"`
+	Role            string      `json:"role"`
+	Category        string      `json:"category"`
+	Group           string      `json:"group"`
+	SecondaryGroups []string    `json:"secondary_groups"`
+	Active          bool        `json:"active"`
+	Quota           interface{} `json:"quota"` // false (hereda del grupo) u objeto con la cuota
+}
+
+// CreateUser crea un nuevo usuario
+func (c *Client) CreateUser(user *User, password string) (string, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user", c.HostURL)
+
+	// Construir el payload
+	payload := map[string]interface{}{
+		"provider":         user.Provider,
+		"username":         user.Username,
+		"name":             user.Name,
+		"email":            user.Email,
+		"role":             user.Role,
+		"category":         user.Category,
+		"group":            user.Group,
+		"secondary_groups": user.SecondaryGroups,
+		"active":           user.Active,
+		"quota":            user.Quota,
+		"bulk":             false,
+	}
+
+	if user.SecondaryGroups == nil {
+		payload["secondary_groups"] = []string{}
+	}
+
+	if user.Quota == nil {
+		payload["quota"] = false
+	}
+
+	if password != "" {
+		payload["password"] = password
+	}
+
+	jsonData, err := json.Marshal(payload)
+	if err != nil {
+		return "", fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("POST", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("error creando la petición POST: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error ejecutando POST: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return "", fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK && res.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("error creando usuario (status %d): %s", res.StatusCode, string(body))
+	}
+
+	// Parsear la respuesta para obtener el ID
+	var response map[string]interface{}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return "", fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	userID, ok := response["id"].(string)
+	if !ok {
+		return "", fmt.Errorf("no se encontró el ID en la respuesta: %s", string(body))
+	}
+
+	return userID, nil
+}
+
+// GetUser obtiene la información de un usuario
+func (c *Client) GetUser(userID string) (*User, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s", c.HostURL, userID)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo usuario (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var user User
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &user, nil
+}
+
+// GetUsers obtiene la lista de usuarios
+func (c *Client) GetUsers() ([]User, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/users", c.HostURL)
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+
+	body, err := c.doRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("error obteniendo usuarios: %w", err)
+	}
+
+	var users []User
+	if err := json.Unmarshal(body, &users); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta: %w", err)
+	}
+
+	return users, nil
+}
+
+// UpdateUser actualiza un usuario existente
+func (c *Client) UpdateUser(userID string, updateData map[string]interface{}) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s", c.HostURL, userID)
+
+	jsonData, err := json.Marshal(updateData)
+	if err != nil {
+		return fmt.Errorf("error codificando JSON: %w", err)
+	}
+
+	req, err := http.NewRequest("PUT", reqURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return fmt.Errorf("error creando la petición PUT: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando PUT: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("error actualizando usuario (status %d): %s", res.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DeleteUser elimina un usuario
+func (c *Client) DeleteUser(userID string) error {
+	reqURL := fmt.Sprintf("https://%s/api/v3/admin/user/%s", c.HostURL, userID)
+
+	req, err := http.NewRequest("DELETE", reqURL, nil)
+	if err != nil {
+		return fmt.Errorf("error creando la petición DELETE: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error ejecutando DELETE: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	// Considerar éxito los códigos 200, 204 (No Content) y 404 (ya no existe)
+	if res.StatusCode == http.StatusOK || res.StatusCode == http.StatusNoContent || res.StatusCode == http.StatusNotFound {
+		return nil
+	}
+
+	return fmt.Errorf("error eliminando usuario (status %d): %s", res.StatusCode, string(body))
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DesktopViewer representa el acceso a un desktop arrancado a través de un viewer.
+// Los viewers de navegador devuelven una URL y los de fichero el contenido del fichero.
+type DesktopViewer struct {
+	Kind     string `json:"kind"`
+	Protocol string `json:"protocol"`
+	URL      string `json:"viewer"`
+	Name     string `json:"name"`
+	Ext      string `json:"ext"`
+	Mime     string `json:"mime"`
+	Content  string `json:"content"`
+	// Expires es la caducidad del acceso: timestamp Unix o fecha en texto según la versión de Isard
+	Expires interface{} `json:"expires"`
+}
+
+// ExpiresAt devuelve la caducidad del acceso en formato RFC 3339 ("" si la API no la indica)
+func (v *DesktopViewer) ExpiresAt() string {
+	switch expires := v.Expires.(type) {
+	case float64:
+		return time.Unix(int64(expires), 0).UTC().Format(time.RFC3339)
+	case string:
+		return expires
+	}
+	return ""
+}
+
+// FileName devuelve el nombre del fichero de conexión (p. ej. "desktop.rdp") de los viewers de fichero
+func (v *DesktopViewer) FileName() string {
+	if v.Name == "" || v.Ext == "" {
+		return v.Name
+	}
+	return v.Name + "." + v.Ext
+}
+
+// GetDesktopViewer obtiene el acceso al desktop con el viewer indicado.
+// viewerType usa la misma notación que guest_properties (browser_vnc, file_spice...).
+func (c *Client) GetDesktopViewer(desktopID, viewerType string) (*DesktopViewer, error) {
+	reqURL := fmt.Sprintf("https://%s/api/v3/desktop/%s/viewer/%s", c.HostURL, desktopID, strings.ReplaceAll(viewerType, "_", "-"))
+
+	req, err := http.NewRequest("GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creando la petición GET: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.Token)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error ejecutando GET: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error leyendo respuesta: %w", err)
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("desktop not found")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error obteniendo viewer (status %d): %s", res.StatusCode, string(body))
+	}
+
+	var viewer DesktopViewer
+	if err := json.Unmarshal(body, &viewer); err != nil {
+		return nil, fmt.Errorf("error parseando respuesta JSON: %w", err)
+	}
+
+	return &viewer, nil
+}
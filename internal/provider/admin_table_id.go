@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"regexp"
+	"strings"
+)
+
+// adminTableIDRegexp valida los IDs de las tablas de administración (QoS...), que se usan en URLs
+var adminTableIDRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// idFromName deriva un ID estable a partir del nombre: minúsculas, sin
+// acentos y con guiones en lugar de espacios y otros símbolos
+func idFromName(name string) string {
+	name = strings.NewReplacer(
+		"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n", "ç", "c",
+		"Á", "a", "É", "e", "Í", "i", "Ó", "o", "Ú", "u", "Ü", "u", "Ñ", "n", "Ç", "c",
+	).Replace(name)
+
+	var id strings.Builder
+	dash := false
+	for _, c := range strings.ToLower(name) {
+		if (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9') {
+			if dash && id.Len() > 0 {
+				id.WriteByte('-')
+			}
+			id.WriteRune(c)
+			dash = false
+			continue
+		}
+		dash = true
+	}
+
+	return id.String()
+}
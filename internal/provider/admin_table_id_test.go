@@ -0,0 +1,30 @@
+package provider
+
+import "testing"
+
+func TestIDFromName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"Disco Lento", "disco-lento"},
+		{"  Límite   de E/S (aulas) ", "limite-de-e-s-aulas"},
+		{"Pequeño Ñandú", "pequeno-nandu"},
+		{"IOPS_500", "iops-500"},
+		{"ya-en-minúsculas", "ya-en-minusculas"},
+		{"---", ""},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := idFromName(tt.name)
+			if got != tt.want {
+				t.Errorf("idFromName(%q) = %q, want %q", tt.name, got, tt.want)
+			}
+			if got != "" && !adminTableIDRegexp.MatchString(got) {
+				t.Errorf("idFromName(%q) = %q no es un ID válido", tt.name, got)
+			}
+		})
+	}
+}
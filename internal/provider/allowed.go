@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// allowedKeys son las claves del mapa allowed de la API de Isard
+var allowedKeys = []string{"roles", "categories", "groups", "users"}
+
+// allowedModel representa los permisos de acceso (compartición) de un recurso.
+//
+// La API de Isard usa dos valores distintos en cada clave:
+//   - false: no se comparte por esa dimensión
+//   - lista: se comparte con los IDs indicados (lista vacía = todos)
+//
+// En Terraform una lista omitida (null) equivale a false y una lista vacía a [],
+// de modo que la conversión es reversible en ambos sentidos.
+type allowedModel struct {
+	Roles      types.List `tfsdk:"roles"`
+	Categories types.List `tfsdk:"categories"`
+	Groups     types.List `tfsdk:"groups"`
+	Users      types.List `tfsdk:"users"`
+}
+
+// allowedNestedAttributes devuelve los atributos comunes del bloque/atributo allowed
+func allowedNestedAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"roles": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Lista de IDs de roles permitidos. Lista vacía = todos los roles. Omitir = no se comparte por rol.",
+		},
+		"categories": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Lista de IDs de categorías permitidas. Lista vacía = todas las categorías. Omitir = no se comparte por categoría.",
+		},
+		"groups": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Lista de IDs de grupos permitidos. Lista vacía = todos los grupos. Omitir = no se comparte por grupo.",
+		},
+		"users": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Optional:            true,
+			MarkdownDescription: "Lista de IDs de usuarios permitidos. Lista vacía = todos los usuarios. Omitir = no se comparte por usuario.",
+		},
+	}
+}
+
+// allowedBlockSchema devuelve el esquema del bloque allowed
+func allowedBlockSchema(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description,
+		Attributes:          allowedNestedAttributes(),
+	}
+}
+
+// allowedAttributeSchema devuelve el esquema del atributo anidado allowed
+func allowedAttributeSchema(description string, required bool) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required:            required,
+		Optional:            !required,
+		MarkdownDescription: description,
+		Attributes:          allowedNestedAttributes(),
+	}
+}
+
+// toAPI convierte el modelo al mapa allowed que espera la API.
+// Siempre incluye las cuatro claves para que quitar una lista deje de compartir.
+func (m *allowedModel) toAPI(ctx context.Context) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	allowed := make(map[string]interface{}, len(allowedKeys))
+	lists := map[string]types.List{
+		"roles":      m.Roles,
+		"categories": m.Categories,
+		"groups":     m.Groups,
+		"users":      m.Users,
+	}
+
+	for _, key := range allowedKeys {
+		list := lists[key]
+		if list.IsNull() || list.IsUnknown() {
+			allowed[key] = false
+			continue
+		}
+
+		values := []string{}
+		diags.Append(list.ElementsAs(ctx, &values, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		allowed[key] = values
+	}
+
+	return allowed, diags
+}
+
+// allowedFromAPI convierte el mapa allowed de la API al modelo de Terraform
+func allowedFromAPI(ctx context.Context, allowed map[string]interface{}) (*allowedModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	lists := make(map[string]types.List, len(allowedKeys))
+	for _, key := range allowedKeys {
+		raw, ok := allowed[key].([]interface{})
+		if !ok {
+			// false, null o clave ausente: no se comparte por esta dimensión
+			lists[key] = types.ListNull(types.StringType)
+			continue
+		}
+
+		values := make([]string, 0, len(raw))
+		for _, v := range raw {
+			values = append(values, fmt.Sprintf("%v", v))
+		}
+
+		list, d := types.ListValueFrom(ctx, types.StringType, values)
+		diags.Append(d...)
+		lists[key] = list
+	}
+
+	return &allowedModel{
+		Roles:      lists["roles"],
+		Categories: lists["categories"],
+		Groups:     lists["groups"],
+		Users:      lists["users"],
+	}, diags
+}
+
+// ownerOnlyAllowed devuelve el mapa allowed por defecto de redes y medios,
+// que no comparte el recurso con nadie más que el propietario
+func ownerOnlyAllowed() map[string]interface{} {
+	return map[string]interface{}{
+		"roles":      false,
+		"categories": false,
+		"groups":     false,
+		"users":      []interface{}{},
+	}
+}
+
+// allowedIsShared indica si el mapa allowed de la API difiere del valor por
+// defecto de ownerOnlyAllowed, es decir, si comparte el recurso con alguien
+func allowedIsShared(allowed map[string]interface{}) bool {
+	defaults := ownerOnlyAllowed()
+	for _, key := range allowedKeys {
+		values, ok := allowed[key].([]interface{})
+		if !ok {
+			continue
+		}
+		if _, isList := defaults[key].([]interface{}); isList && len(values) == 0 {
+			continue
+		}
+		return true
+	}
+	return false
+}
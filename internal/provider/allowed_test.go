@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func stringList(t *testing.T, values ...string) types.List {
+	t.Helper()
+	if values == nil {
+		values = []string{}
+	}
+	list, diags := types.ListValueFrom(context.Background(), types.StringType, values)
+	if diags.HasError() {
+		t.Fatalf("ListValueFrom: %v", diags)
+	}
+	return list
+}
+
+func allowedModelsEqual(a, b allowedModel) bool {
+	return a.Roles.Equal(b.Roles) && a.Categories.Equal(b.Categories) &&
+		a.Groups.Equal(b.Groups) && a.Users.Equal(b.Users)
+}
+
+func TestAllowedModelToAPI(t *testing.T) {
+	null := types.ListNull(types.StringType)
+
+	tests := []struct {
+		name  string
+		model allowedModel
+		want  map[string]interface{}
+	}{
+		{
+			name:  "todo omitido",
+			model: allowedModel{Roles: null, Categories: null, Groups: null, Users: null},
+			want:  map[string]interface{}{"roles": false, "categories": false, "groups": false, "users": false},
+		},
+		{
+			name:  "lista vacía",
+			model: allowedModel{Roles: null, Categories: null, Groups: stringList(t), Users: null},
+			want:  map[string]interface{}{"roles": false, "categories": false, "groups": []string{}, "users": false},
+		},
+		{
+			name:  "IDs concretos",
+			model: allowedModel{Roles: stringList(t, "admin"), Categories: null, Groups: null, Users: stringList(t, "u1", "u2")},
+			want:  map[string]interface{}{"roles": []string{"admin"}, "categories": false, "groups": false, "users": []string{"u1", "u2"}},
+		},
+		{
+			name:  "valor desconocido",
+			model: allowedModel{Roles: types.ListUnknown(types.StringType), Categories: null, Groups: null, Users: null},
+			want:  map[string]interface{}{"roles": false, "categories": false, "groups": false, "users": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := tt.model.toAPI(context.Background())
+			if diags.HasError() {
+				t.Fatalf("toAPI: %v", diags)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("toAPI = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowedFromAPI(t *testing.T) {
+	null := types.ListNull(types.StringType)
+
+	tests := []struct {
+		name    string
+		allowed map[string]interface{}
+		want    allowedModel
+	}{
+		{
+			name:    "false",
+			allowed: map[string]interface{}{"roles": false, "categories": false, "groups": false, "users": false},
+			want:    allowedModel{Roles: null, Categories: null, Groups: null, Users: null},
+		},
+		{
+			name:    "claves ausentes o null",
+			allowed: map[string]interface{}{"roles": nil},
+			want:    allowedModel{Roles: null, Categories: null, Groups: null, Users: null},
+		},
+		{
+			name:    "listas",
+			allowed: map[string]interface{}{"roles": []interface{}{}, "categories": false, "groups": []interface{}{"g1"}, "users": false},
+			want:    allowedModel{Roles: stringList(t), Categories: null, Groups: stringList(t, "g1"), Users: null},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := allowedFromAPI(context.Background(), tt.allowed)
+			if diags.HasError() {
+				t.Fatalf("allowedFromAPI: %v", diags)
+			}
+			if !allowedModelsEqual(*got, tt.want) {
+				t.Errorf("allowedFromAPI = %#v, want %#v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowedIsShared(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed map[string]interface{}
+		want    bool
+	}{
+		{"valor por defecto", ownerOnlyAllowed(), false},
+		{"todo false", map[string]interface{}{"roles": false, "categories": false, "groups": false, "users": false}, false},
+		{"usuarios concretos", map[string]interface{}{"roles": false, "categories": false, "groups": false, "users": []interface{}{"u1"}}, true},
+		{"todos los grupos", map[string]interface{}{"roles": false, "categories": false, "groups": []interface{}{}, "users": []interface{}{}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := allowedIsShared(tt.allowed); got != tt.want {
+				t.Errorf("allowedIsShared(%v) = %v, want %v", tt.allowed, got, tt.want)
+			}
+		})
+	}
+}
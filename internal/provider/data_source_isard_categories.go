@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &categoriesDataSource{}
+	_ datasource.DataSourceWithConfigure = &categoriesDataSource{}
+)
+
+// NewCategoriesDataSource is a helper function to simplify the provider implementation.
+func NewCategoriesDataSource() datasource.DataSource {
+	return &categoriesDataSource{}
+}
+
+// categoriesDataSource is the data source implementation.
+type categoriesDataSource struct {
+	client *client.Client
+}
+
+// categoriesDataSourceModel maps the data source schema data.
+type categoriesDataSourceModel struct {
+	ID         types.String          `tfsdk:"id"`
+	Filter     *categoryFilterModel  `tfsdk:"filter"`
+	Categories []categoryDetailModel `tfsdk:"categories"`
+}
+
+// categoryFilterModel maps the filter schema.
+type categoryFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	Frontend types.Bool   `tfsdk:"frontend"`
+}
+
+// categoryDetailModel maps individual category details.
+type categoryDetailModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	Frontend         types.Bool   `tfsdk:"frontend"`
+	CustomURLName    types.String `tfsdk:"custom_url_name"`
+	EphemeralMinutes types.Int64  `tfsdk:"ephemeral_minutes"`
+	EphemeralAction  types.String `tfsdk:"ephemeral_action"`
+}
+
+// Metadata returns the data source type name.
+func (d *categoriesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_categories"
+}
+
+// Schema defines the schema for the data source.
+func (d *categoriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene una lista de categorías (solo administradores). Permite filtrar por nombre y visibilidad en el login.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar categorías.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre de la categoría (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"frontend": schema.BoolAttribute{
+						Description: "Si la categoría aparece en la página de login.",
+						Optional:    true,
+					},
+				},
+			},
+			"categories": schema.ListNestedAttribute{
+				Description: "Lista de categorías encontradas.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único de la categoría.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre de la categoría.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción de la categoría.",
+							Computed:    true,
+						},
+						"frontend": schema.BoolAttribute{
+							Description: "Si la categoría aparece en la página de login.",
+							Computed:    true,
+						},
+						"custom_url_name": schema.StringAttribute{
+							Description: "Nombre usado en la URL de login propia de la categoría.",
+							Computed:    true,
+						},
+						"ephemeral_minutes": schema.Int64Attribute{
+							Description: "Minutos de vida de los desktops efímeros (null si está deshabilitado).",
+							Computed:    true,
+						},
+						"ephemeral_action": schema.StringAttribute{
+							Description: "Acción al expirar un desktop efímero (null si está deshabilitado).",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *categoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state categoriesDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Obtener todas las categorías
+	categories, err := d.client.GetCategories()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo categorías",
+			"No se pudo obtener la lista de categorías: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.Categories = []categoryDetailModel{}
+	for _, category := range categories {
+		if state.Filter != nil {
+			if state.Filter.Name.ValueString() != "" && !containsIgnoreCase(category.Name, state.Filter.Name.ValueString()) {
+				continue
+			}
+			if !state.Filter.Frontend.IsNull() && category.Frontend != state.Filter.Frontend.ValueBool() {
+				continue
+			}
+		}
+
+		detail := categoryDetailModel{
+			ID:               types.StringValue(category.ID),
+			Name:             types.StringValue(category.Name),
+			Description:      types.StringValue(category.Description),
+			Frontend:         types.BoolValue(category.Frontend),
+			CustomURLName:    types.StringValue(category.CustomURLName),
+			EphemeralMinutes: types.Int64Null(),
+			EphemeralAction:  types.StringNull(),
+		}
+		if ephemeral := ephemeralFromAPI(category.Ephemeral); ephemeral != nil {
+			detail.EphemeralMinutes = ephemeral.Minutes
+			detail.EphemeralAction = ephemeral.Action
+		}
+
+		state.Categories = append(state.Categories, detail)
+	}
+
+	state.ID = types.StringValue("categories")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *categoriesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,165 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &deploymentDesktopsDataSource{}
+	_ datasource.DataSourceWithConfigure = &deploymentDesktopsDataSource{}
+)
+
+// NewDeploymentDesktopsDataSource is a helper function to simplify the provider implementation.
+func NewDeploymentDesktopsDataSource() datasource.DataSource {
+	return &deploymentDesktopsDataSource{}
+}
+
+// deploymentDesktopsDataSource is the data source implementation.
+type deploymentDesktopsDataSource struct {
+	client *client.Client
+}
+
+// deploymentDesktopsDataSourceModel maps the data source schema data.
+type deploymentDesktopsDataSourceModel struct {
+	ID           types.String                   `tfsdk:"id"`
+	DeploymentID types.String                   `tfsdk:"deployment_id"`
+	Desktops     []deploymentDesktopDetailModel `tfsdk:"desktops"`
+}
+
+// deploymentDesktopDetailModel maps individual desktop details.
+type deploymentDesktopDetailModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	User       types.String `tfsdk:"user"`
+	Username   types.String `tfsdk:"username"`
+	Status     types.String `tfsdk:"status"`
+	DirectLink types.String `tfsdk:"direct_link"`
+}
+
+// Metadata returns the data source type name.
+func (d *deploymentDesktopsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_desktops"
+}
+
+// Schema defines the schema for the data source.
+func (d *deploymentDesktopsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene los desktops creados por un deployment, con su usuario y su enlace directo.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source (igual a deployment_id).",
+				Computed:    true,
+			},
+			"deployment_id": schema.StringAttribute{
+				Description: "ID del deployment.",
+				Required:    true,
+			},
+			"desktops": schema.ListNestedAttribute{
+				Description: "Lista de desktops del deployment.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID del desktop.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre del desktop.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "ID del usuario al que pertenece el desktop.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Nombre de usuario al que pertenece el desktop.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Estado del desktop.",
+							Computed:    true,
+						},
+						"direct_link": schema.StringAttribute{
+							Description: "URL del enlace directo del desktop (vacía si está deshabilitado).",
+							Computed:    true,
+							Sensitive:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *deploymentDesktopsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state deploymentDesktopsDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := d.client.GetDeployment(state.DeploymentID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo deployment",
+			"No se pudo obtener el deployment "+state.DeploymentID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Desktops = []deploymentDesktopDetailModel{}
+	for _, desktop := range deployment.Desktops {
+		token, err := d.client.GetDirectLink(desktop.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error obteniendo enlace directo",
+				"No se pudo obtener el enlace directo del desktop "+desktop.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		state.Desktops = append(state.Desktops, deploymentDesktopDetailModel{
+			ID:         types.StringValue(desktop.ID),
+			Name:       types.StringValue(desktop.Name),
+			User:       types.StringValue(desktop.User),
+			Username:   types.StringValue(desktop.Username),
+			Status:     types.StringValue(desktop.Status),
+			DirectLink: types.StringValue(d.client.DirectLinkURL(token)),
+		})
+	}
+
+	state.ID = state.DeploymentID
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *deploymentDesktopsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
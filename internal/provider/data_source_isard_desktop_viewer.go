@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &desktopViewerDataSource{}
+	_ datasource.DataSourceWithConfigure = &desktopViewerDataSource{}
+)
+
+// NewDesktopViewerDataSource is a helper function to simplify the provider implementation.
+func NewDesktopViewerDataSource() datasource.DataSource {
+	return &desktopViewerDataSource{}
+}
+
+// desktopViewerDataSource is the data source implementation.
+type desktopViewerDataSource struct {
+	client *client.Client
+}
+
+// desktopViewerDataSourceModel maps the data source schema data.
+type desktopViewerDataSourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	DesktopID  types.String `tfsdk:"desktop_id"`
+	ViewerType types.String `tfsdk:"viewer_type"`
+	Kind       types.String `tfsdk:"kind"`
+	URL        types.String `tfsdk:"url"`
+	Content    types.String `tfsdk:"content"`
+	FileName   types.String `tfsdk:"file_name"`
+	MimeType   types.String `tfsdk:"mime_type"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the data source type name.
+func (d *desktopViewerDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_desktop_viewer"
+}
+
+// Schema defines the schema for the data source.
+func (d *desktopViewerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene el acceso a un desktop arrancado: el fichero de conexión (.vv, .rdp) o la URL del viewer de navegador que genera Isard.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source (<desktop_id>/<viewer_type>).",
+				Computed:    true,
+			},
+			"desktop_id": schema.StringAttribute{
+				Description: "ID del desktop. Debe estar arrancado.",
+				Required:    true,
+			},
+			"viewer_type": schema.StringAttribute{
+				Description: "Tipo de viewer (browser_vnc, file_spice, browser_rdp, file_rdpgw, file_rdpvpn).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(viewerTypes...),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "Tipo de acceso devuelto: browser (URL) o file (fichero de conexión).",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL del viewer de navegador (vacía para viewers de fichero).",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"content": schema.StringAttribute{
+				Description: "Contenido del fichero de conexión (vacío para viewers de navegador).",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"file_name": schema.StringAttribute{
+				Description: "Nombre sugerido para el fichero de conexión.",
+				Computed:    true,
+			},
+			"mime_type": schema.StringAttribute{
+				Description: "Tipo MIME del fichero de conexión.",
+				Computed:    true,
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Caducidad del acceso en formato RFC 3339 (vacía si Isard no la indica).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *desktopViewerDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state desktopViewerDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewer, err := d.client.GetDesktopViewer(state.DesktopID.ValueString(), state.ViewerType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo viewer",
+			"No se pudo obtener el viewer "+state.ViewerType.ValueString()+" del desktop "+state.DesktopID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.DesktopID.ValueString() + "/" + state.ViewerType.ValueString())
+	state.Kind = types.StringValue(viewer.Kind)
+	state.URL = types.StringValue(viewer.URL)
+	state.Content = types.StringValue(viewer.Content)
+	state.FileName = types.StringValue(viewer.FileName())
+	state.MimeType = types.StringValue(viewer.Mime)
+	state.ExpiresAt = types.StringValue(viewer.ExpiresAt())
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *desktopViewerDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &gpuProfilesDataSource{}
+	_ datasource.DataSourceWithConfigure = &gpuProfilesDataSource{}
+)
+
+// NewGPUProfilesDataSource is a helper function to simplify the provider implementation.
+func NewGPUProfilesDataSource() datasource.DataSource {
+	return &gpuProfilesDataSource{}
+}
+
+// gpuProfilesDataSource is the data source implementation.
+type gpuProfilesDataSource struct {
+	client *client.Client
+}
+
+// gpuProfilesDataSourceModel maps the data source schema data.
+type gpuProfilesDataSourceModel struct {
+	ID       types.String            `tfsdk:"id"`
+	Filter   *gpuProfileFilterModel  `tfsdk:"filter"`
+	Profiles []gpuProfileDetailModel `tfsdk:"profiles"`
+}
+
+// gpuProfileFilterModel maps the filter schema.
+type gpuProfileFilterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Brand types.String `tfsdk:"brand"`
+	Model types.String `tfsdk:"model"`
+}
+
+// gpuProfileDetailModel maps individual GPU profile details.
+type gpuProfileDetailModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Brand       types.String `tfsdk:"brand"`
+	Model       types.String `tfsdk:"model"`
+	Profile     types.String `tfsdk:"profile"`
+	Memory      types.Int64  `tfsdk:"memory"`
+	Units       types.Int64  `tfsdk:"units"`
+}
+
+// Metadata returns the data source type name.
+func (d *gpuProfilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gpu_profiles"
+}
+
+// Schema defines the schema for the data source.
+func (d *gpuProfilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene los perfiles de vGPU que se pueden reservar. Permite filtrar por nombre, marca y modelo.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar perfiles.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre del perfil (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"brand": schema.StringAttribute{
+						Description: "Marca de la GPU, p. ej. NVIDIA (case-insensitive).",
+						Optional:    true,
+					},
+					"model": schema.StringAttribute{
+						Description: "Modelo de la GPU, p. ej. A40 (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+				},
+			},
+			"profiles": schema.ListNestedAttribute{
+				Description: "Lista de perfiles encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID del perfil, usado en `reservables.vgpu`.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre del perfil.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción del perfil.",
+							Computed:    true,
+						},
+						"brand": schema.StringAttribute{
+							Description: "Marca de la GPU.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "Modelo de la GPU.",
+							Computed:    true,
+						},
+						"profile": schema.StringAttribute{
+							Description: "Nombre del perfil de vGPU del fabricante (p. ej. 4Q).",
+							Computed:    true,
+						},
+						"memory": schema.Int64Attribute{
+							Description: "Memoria de vídeo del perfil en MB.",
+							Computed:    true,
+						},
+						"units": schema.Int64Attribute{
+							Description: "Número de unidades de este perfil disponibles en total.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *gpuProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state gpuProfilesDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profiles, err := d.client.ListGPUProfiles()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo perfiles de GPU",
+			"No se pudo obtener la lista de perfiles de GPU: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.Profiles = []gpuProfileDetailModel{}
+	for _, profile := range profiles {
+		if !matchesGPUProfileFilter(profile, state.Filter) {
+			continue
+		}
+
+		state.Profiles = append(state.Profiles, gpuProfileDetailModel{
+			ID:          types.StringValue(profile.ID),
+			Name:        types.StringValue(profile.Name),
+			Description: types.StringValue(profile.Description),
+			Brand:       types.StringValue(profile.Brand),
+			Model:       types.StringValue(profile.Model),
+			Profile:     types.StringValue(profile.Profile),
+			Memory:      types.Int64Value(profile.Memory),
+			Units:       types.Int64Value(profile.Units),
+		})
+	}
+
+	state.ID = types.StringValue("gpu_profiles")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matchesGPUProfileFilter indica si un perfil cumple todos los filtros indicados
+func matchesGPUProfileFilter(profile client.GPUProfile, filter *gpuProfileFilterModel) bool {
+	if filter == nil {
+		return true
+	}
+
+	if name := filter.Name.ValueString(); name != "" && !containsIgnoreCase(profile.Name, name) {
+		return false
+	}
+
+	if brand := filter.Brand.ValueString(); brand != "" && toLower(profile.Brand) != toLower(brand) {
+		return false
+	}
+
+	if model := filter.Model.ValueString(); model != "" && !containsIgnoreCase(profile.Model, model) {
+		return false
+	}
+
+	return true
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *gpuProfilesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -32,6 +33,15 @@ type groupModel struct {
 	Name           types.String `tfsdk:"name"`
 	Description    types.String `tfsdk:"description"`
 	ParentCategory types.String `tfsdk:"parent_category"`
+	LinkedGroups   types.List   `tfsdk:"linked_groups"`
+	Enrollment     types.Object `tfsdk:"enrollment"`
+}
+
+// groupEnrollmentAttrTypes son los tipos del objeto enrollment (un código por rol)
+var groupEnrollmentAttrTypes = map[string]attr.Type{
+	"advanced": types.StringType,
+	"manager":  types.StringType,
+	"user":     types.StringType,
 }
 
 func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -75,6 +85,29 @@ func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 							Description: "Parent category ID.",
 							Computed:    true,
 						},
+						"linked_groups": schema.ListAttribute{
+							Description: "IDs of the linked groups.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"enrollment": schema.SingleNestedAttribute{
+							Description: "Enrollment codes per role. A null code means enrollment is disabled for that role.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"advanced": schema.StringAttribute{
+									Description: "Enrollment code for the advanced role.",
+									Computed:    true,
+								},
+								"manager": schema.StringAttribute{
+									Description: "Enrollment code for the manager role.",
+									Computed:    true,
+								},
+								"user": schema.StringAttribute{
+									Description: "Enrollment code for the user role.",
+									Computed:    true,
+								},
+							},
+						},
 					},
 				},
 			},
@@ -135,11 +168,33 @@ func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Map filtered groups to model
 	data.Groups = make([]groupModel, len(filteredGroups))
 	for i, group := range filteredGroups {
+		linkedGroups := group.LinkedGroups
+		if linkedGroups == nil {
+			linkedGroups = []string{}
+		}
+		linkedGroupsList, diags := types.ListValueFrom(ctx, types.StringType, linkedGroups)
+		resp.Diagnostics.Append(diags...)
+
+		enrollment := make(map[string]attr.Value, len(groupEnrollmentAttrTypes))
+		for role := range groupEnrollmentAttrTypes {
+			enrollment[role] = types.StringNull()
+			if code := group.EnrollmentCode(role); code != "" {
+				enrollment[role] = types.StringValue(code)
+			}
+		}
+		enrollmentObject, diags := types.ObjectValue(groupEnrollmentAttrTypes, enrollment)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
 		data.Groups[i] = groupModel{
 			ID:             types.StringValue(group.ID),
 			Name:           types.StringValue(group.Name),
 			Description:    types.StringValue(group.Description),
 			ParentCategory: types.StringValue(group.ParentCategory),
+			LinkedGroups:   linkedGroupsList,
+			Enrollment:     enrollmentObject,
 		}
 	}
 
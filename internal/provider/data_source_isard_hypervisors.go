@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &hypervisorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &hypervisorsDataSource{}
+)
+
+// NewHypervisorsDataSource is a helper function to simplify the provider implementation.
+func NewHypervisorsDataSource() datasource.DataSource {
+	return &hypervisorsDataSource{}
+}
+
+// hypervisorsDataSource is the data source implementation.
+type hypervisorsDataSource struct {
+	client *client.Client
+}
+
+// hypervisorsDataSourceModel maps the data source schema data.
+type hypervisorsDataSourceModel struct {
+	ID          types.String            `tfsdk:"id"`
+	Filter      *hypervisorFilterModel  `tfsdk:"filter"`
+	Hypervisors []hypervisorDetailModel `tfsdk:"hypervisors"`
+}
+
+// hypervisorFilterModel maps the filter schema.
+type hypervisorFilterModel struct {
+	Hostname types.String `tfsdk:"hostname"`
+	Status   types.String `tfsdk:"status"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+}
+
+// hypervisorDetailModel maps individual hypervisor details.
+type hypervisorDetailModel struct {
+	ID                types.String  `tfsdk:"id"`
+	Hostname          types.String  `tfsdk:"hostname"`
+	Port              types.Int64   `tfsdk:"port"`
+	Status            types.String  `tfsdk:"status"`
+	Enabled           types.Bool    `tfsdk:"enabled"`
+	OnlyForced        types.Bool    `tfsdk:"only_forced"`
+	GPUEnabled        types.Bool    `tfsdk:"gpu_enabled"`
+	DiskOperations    types.Bool    `tfsdk:"disk_operations"`
+	Virtualization    types.Bool    `tfsdk:"virtualization"`
+	DesktopsStarted   types.Int64   `tfsdk:"desktops_started"`
+	CPUPercent        types.Float64 `tfsdk:"cpu_percent"`
+	MemoryUsedPercent types.Float64 `tfsdk:"memory_used_percent"`
+}
+
+// Metadata returns the data source type name.
+func (d *hypervisorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hypervisors"
+}
+
+// Schema defines the schema for the data source.
+func (d *hypervisorsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene la lista de hipervisores con su estado y carga (solo administradores). Permite filtrar por hostname, estado y habilitado.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar hipervisores.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"hostname": schema.StringAttribute{
+						Description: "Hostname del hipervisor (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"status": schema.StringAttribute{
+						Description: "Estado exacto del hipervisor (Online, Offline, Error...).",
+						Optional:    true,
+					},
+					"enabled": schema.BoolAttribute{
+						Description: "Si el hipervisor está habilitado.",
+						Optional:    true,
+					},
+				},
+			},
+			"hypervisors": schema.ListNestedAttribute{
+				Description: "Lista de hipervisores encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único del hipervisor.",
+							Computed:    true,
+						},
+						"hostname": schema.StringAttribute{
+							Description: "Hostname o IP del hipervisor.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Puerto SSH del hipervisor.",
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: "Estado del hipervisor.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Si el hipervisor está habilitado.",
+							Computed:    true,
+						},
+						"only_forced": schema.BoolAttribute{
+							Description: "Si solo arranca desktops que lo fuerzan explícitamente.",
+							Computed:    true,
+						},
+						"gpu_enabled": schema.BoolAttribute{
+							Description: "Si el hipervisor ofrece GPUs NVIDIA.",
+							Computed:    true,
+						},
+						"disk_operations": schema.BoolAttribute{
+							Description: "Si el hipervisor realiza operaciones de disco.",
+							Computed:    true,
+						},
+						"virtualization": schema.BoolAttribute{
+							Description: "Si el hipervisor arranca desktops.",
+							Computed:    true,
+						},
+						"desktops_started": schema.Int64Attribute{
+							Description: "Número de desktops arrancados en el hipervisor.",
+							Computed:    true,
+						},
+						"cpu_percent": schema.Float64Attribute{
+							Description: "Uso de CPU en porcentaje.",
+							Computed:    true,
+						},
+						"memory_used_percent": schema.Float64Attribute{
+							Description: "Uso de memoria en porcentaje.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *hypervisorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state hypervisorsDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisors, err := d.client.ListHypervisors()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo hipervisores",
+			"No se pudo obtener la lista de hipervisores: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.Hypervisors = []hypervisorDetailModel{}
+	for _, hyper := range hypervisors {
+		if state.Filter != nil {
+			if state.Filter.Hostname.ValueString() != "" && !containsIgnoreCase(hyper.Hostname, state.Filter.Hostname.ValueString()) {
+				continue
+			}
+			if !state.Filter.Status.IsNull() && hyper.Status != state.Filter.Status.ValueString() {
+				continue
+			}
+			if !state.Filter.Enabled.IsNull() && hyper.Enabled != state.Filter.Enabled.ValueBool() {
+				continue
+			}
+		}
+
+		state.Hypervisors = append(state.Hypervisors, hypervisorDetailModel{
+			ID:                types.StringValue(hyper.ID),
+			Hostname:          types.StringValue(hyper.Hostname),
+			Port:              types.Int64Value(hyper.Port),
+			Status:            types.StringValue(hyper.Status),
+			Enabled:           types.BoolValue(hyper.Enabled),
+			OnlyForced:        types.BoolValue(hyper.OnlyForced),
+			GPUEnabled:        types.BoolValue(hyper.GPUEnabled),
+			DiskOperations:    types.BoolValue(hyper.DiskOperations),
+			Virtualization:    types.BoolValue(hyper.Virtualization),
+			DesktopsStarted:   types.Int64Value(hyper.DesktopsStarted),
+			CPUPercent:        types.Float64Value(hyper.CPUPercent),
+			MemoryUsedPercent: types.Float64Value(hyper.MemoryUsedPercent),
+		})
+	}
+
+	state.ID = types.StringValue("hypervisors")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *hypervisorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
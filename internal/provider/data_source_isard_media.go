@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &mediaDataSource{}
+	_ datasource.DataSourceWithConfigure        = &mediaDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &mediaDataSource{}
+)
+
+// NewMediaDataSource is a helper function to simplify the provider implementation.
+func NewMediaDataSource() datasource.DataSource {
+	return &mediaDataSource{}
+}
+
+// mediaDataSource is the data source implementation.
+type mediaDataSource struct {
+	client *client.Client
+}
+
+// mediaDataSourceModel maps the data source schema data.
+type mediaDataSourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Kind        types.String `tfsdk:"kind"`
+	Description types.String `tfsdk:"description"`
+	URL         types.String `tfsdk:"url"`
+	Status      types.String `tfsdk:"status"`
+	User        types.String `tfsdk:"user"`
+}
+
+// Metadata returns the data source type name.
+func (d *mediaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_media"
+}
+
+// Schema defines the schema for the data source.
+func (d *mediaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene un medio (ISO o floppy) por ID o por nombre exacto.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID del medio. Se debe indicar id o name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Nombre exacto del medio. Se debe indicar id o name.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "Tipo de medio (iso, floppy). Al buscar por nombre, restringe la búsqueda a ese tipo.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(mediaKinds...),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Descripción del medio.",
+				Computed:    true,
+			},
+			"url": schema.StringAttribute{
+				Description: "URL de origen del medio.",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Estado de la descarga.",
+				Computed:    true,
+			},
+			"user": schema.StringAttribute{
+				Description: "ID del usuario propietario.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ConfigValidators exige indicar exactamente uno de id o name.
+func (d *mediaDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *mediaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state mediaDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var media *client.Media
+	if !state.ID.IsNull() {
+		found, err := d.client.GetMedia(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error obteniendo medio",
+				"No se pudo obtener el medio ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+		media = found
+	} else {
+		list, err := d.client.ListMedia()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error obteniendo medios",
+				"No se pudo obtener la lista de medios: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []client.Media
+		for _, m := range list {
+			if m.Name != state.Name.ValueString() {
+				continue
+			}
+			if state.Kind.ValueString() != "" && m.Kind != state.Kind.ValueString() {
+				continue
+			}
+			matches = append(matches, m)
+		}
+
+		if len(matches) != 1 {
+			resp.Diagnostics.AddError(
+				"Medio no encontrado",
+				fmt.Sprintf("Se esperaba un medio con nombre %q y se encontraron %d.", state.Name.ValueString(), len(matches)),
+			)
+			return
+		}
+		media = &matches[0]
+	}
+
+	state.ID = types.StringValue(media.ID)
+	state.Name = types.StringValue(media.Name)
+	state.Kind = types.StringValue(media.Kind)
+	state.Description = types.StringValue(media.Description)
+	state.URL = types.StringValue(media.URL)
+	state.Status = types.StringValue(media.Status)
+	state.User = types.StringValue(media.User)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *mediaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &networksDataSource{}
+	_ datasource.DataSourceWithConfigure = &networksDataSource{}
+)
+
+// NewNetworksDataSource is a helper function to simplify the provider implementation.
+func NewNetworksDataSource() datasource.DataSource {
+	return &networksDataSource{}
+}
+
+// networksDataSource is the data source implementation.
+type networksDataSource struct {
+	client *client.Client
+}
+
+// networksDataSourceModel maps the data source schema data.
+type networksDataSourceModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Filter   *networkFilterModel  `tfsdk:"filter"`
+	Networks []networkDetailModel `tfsdk:"networks"`
+}
+
+// networkFilterModel maps the filter schema.
+type networkFilterModel struct {
+	Name  types.String `tfsdk:"name"`
+	Model types.String `tfsdk:"model"`
+	Owner types.String `tfsdk:"owner"`
+}
+
+// networkDetailModel maps individual network details.
+type networkDetailModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Model       types.String `tfsdk:"model"`
+	QoSID       types.String `tfsdk:"qos_id"`
+	MetadataID  types.String `tfsdk:"metadata_id"`
+	User        types.String `tfsdk:"user"`
+	Group       types.String `tfsdk:"group"`
+	Category    types.String `tfsdk:"category"`
+}
+
+// Metadata returns the data source type name.
+func (d *networksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_networks"
+}
+
+// Schema defines the schema for the data source.
+func (d *networksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene una lista de redes virtuales de usuario. Permite filtrar por nombre, modelo o propietario.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar redes.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre de la red (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"model": schema.StringAttribute{
+						Description: "Modelo de red (virtio, e1000, rtl8139).",
+						Optional:    true,
+					},
+					"owner": schema.StringAttribute{
+						Description: "ID del usuario propietario de la red.",
+						Optional:    true,
+					},
+				},
+			},
+			"networks": schema.ListNestedAttribute{
+				Description: "Lista de redes encontradas.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único de la red.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre de la red.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción de la red.",
+							Computed:    true,
+						},
+						"model": schema.StringAttribute{
+							Description: "Modelo de red.",
+							Computed:    true,
+						},
+						"qos_id": schema.StringAttribute{
+							Description: "ID del perfil QoS.",
+							Computed:    true,
+						},
+						"metadata_id": schema.StringAttribute{
+							Description: "ID de metadata generado para OpenFlow.",
+							Computed:    true,
+						},
+						"user": schema.StringAttribute{
+							Description: "ID del usuario propietario.",
+							Computed:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "ID del grupo del propietario.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "ID de la categoría del propietario.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *networksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state networksDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Obtener todas las redes
+	networks, err := d.client.ListNetworks()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo redes",
+			"No se pudo obtener la lista de redes: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.Networks = []networkDetailModel{}
+	for _, network := range networks {
+		if state.Filter != nil && !d.matchesFilter(network, state.Filter) {
+			continue
+		}
+
+		state.Networks = append(state.Networks, networkDetailModel{
+			ID:          types.StringValue(network.ID),
+			Name:        types.StringValue(network.Name),
+			Description: types.StringValue(network.Description),
+			Model:       types.StringValue(network.Model),
+			QoSID:       types.StringValue(network.QoSID),
+			MetadataID:  types.StringValue(network.MetadataID),
+			User:        types.StringValue(network.User),
+			Group:       types.StringValue(network.Group),
+			Category:    types.StringValue(network.Category),
+		})
+	}
+
+	state.ID = types.StringValue("networks")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matchesFilter indica si una red cumple los filtros especificados
+func (d *networksDataSource) matchesFilter(network client.Network, filter *networkFilterModel) bool {
+	// Filtro por nombre (búsqueda parcial, case-insensitive)
+	if filter.Name.ValueString() != "" && !containsIgnoreCase(network.Name, filter.Name.ValueString()) {
+		return false
+	}
+
+	// Filtro por modelo (exacto)
+	if filter.Model.ValueString() != "" && network.Model != filter.Model.ValueString() {
+		return false
+	}
+
+	// Filtro por propietario (exacto)
+	if filter.Owner.ValueString() != "" && network.User != filter.Owner.ValueString() {
+		return false
+	}
+
+	return true
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *networksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &qosDisksDataSource{}
+	_ datasource.DataSourceWithConfigure = &qosDisksDataSource{}
+)
+
+// NewQoSDisksDataSource is a helper function to simplify the provider implementation.
+func NewQoSDisksDataSource() datasource.DataSource {
+	return &qosDisksDataSource{}
+}
+
+// qosDisksDataSource is the data source implementation.
+type qosDisksDataSource struct {
+	client *client.Client
+}
+
+// qosDisksDataSourceModel maps the data source schema data.
+type qosDisksDataSourceModel struct {
+	ID       types.String         `tfsdk:"id"`
+	Filter   *qosDiskFilterModel  `tfsdk:"filter"`
+	QoSDisks []qosDiskDetailModel `tfsdk:"qos_disks"`
+}
+
+// qosDiskFilterModel maps the filter schema.
+type qosDiskFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// qosDiskDetailModel maps individual disk QoS profile details.
+type qosDiskDetailModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	ReadBytesSec     types.Int64  `tfsdk:"read_bytes_sec"`
+	WriteBytesSec    types.Int64  `tfsdk:"write_bytes_sec"`
+	ReadIOPSSec      types.Int64  `tfsdk:"read_iops_sec"`
+	WriteIOPSSec     types.Int64  `tfsdk:"write_iops_sec"`
+	ReadBytesSecMax  types.Int64  `tfsdk:"read_bytes_sec_max"`
+	WriteBytesSecMax types.Int64  `tfsdk:"write_bytes_sec_max"`
+	ReadIOPSSecMax   types.Int64  `tfsdk:"read_iops_sec_max"`
+	WriteIOPSSecMax  types.Int64  `tfsdk:"write_iops_sec_max"`
+}
+
+// Metadata returns the data source type name.
+func (d *qosDisksDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_disks"
+}
+
+// Schema defines the schema for the data source.
+func (d *qosDisksDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	limit := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description,
+			Computed:    true,
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Obtiene una lista de perfiles QoS de disco (solo administradores). Permite filtrar por nombre.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar perfiles QoS.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre del perfil (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+				},
+			},
+			"qos_disks": schema.ListNestedAttribute{
+				Description: "Lista de perfiles QoS de disco encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único del perfil QoS.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre del perfil QoS.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción del perfil QoS.",
+							Computed:    true,
+						},
+						"read_bytes_sec":      limit("Velocidad de lectura sostenida en bytes/s."),
+						"write_bytes_sec":     limit("Velocidad de escritura sostenida en bytes/s."),
+						"read_iops_sec":       limit("Operaciones de lectura por segundo sostenidas."),
+						"write_iops_sec":      limit("Operaciones de escritura por segundo sostenidas."),
+						"read_bytes_sec_max":  limit("Velocidad de lectura pico en bytes/s."),
+						"write_bytes_sec_max": limit("Velocidad de escritura pico en bytes/s."),
+						"read_iops_sec_max":   limit("Operaciones de lectura por segundo pico."),
+						"write_iops_sec_max":  limit("Operaciones de escritura por segundo pico."),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *qosDisksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state qosDisksDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qosDisks, err := d.client.ListQoSDisks()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo perfiles QoS de disco",
+			"No se pudo obtener la lista de perfiles QoS: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.QoSDisks = []qosDiskDetailModel{}
+	for _, qos := range qosDisks {
+		if state.Filter != nil && state.Filter.Name.ValueString() != "" && !containsIgnoreCase(qos.Name, state.Filter.Name.ValueString()) {
+			continue
+		}
+
+		state.QoSDisks = append(state.QoSDisks, qosDiskDetailModel{
+			ID:               types.StringValue(qos.ID),
+			Name:             types.StringValue(qos.Name),
+			Description:      types.StringValue(qos.Description),
+			ReadBytesSec:     iotuneFromAPI(qos.IOTune, "read_bytes_sec"),
+			WriteBytesSec:    iotuneFromAPI(qos.IOTune, "write_bytes_sec"),
+			ReadIOPSSec:      iotuneFromAPI(qos.IOTune, "read_iops_sec"),
+			WriteIOPSSec:     iotuneFromAPI(qos.IOTune, "write_iops_sec"),
+			ReadBytesSecMax:  iotuneFromAPI(qos.IOTune, "read_bytes_sec_max"),
+			WriteBytesSecMax: iotuneFromAPI(qos.IOTune, "write_bytes_sec_max"),
+			ReadIOPSSecMax:   iotuneFromAPI(qos.IOTune, "read_iops_sec_max"),
+			WriteIOPSSecMax:  iotuneFromAPI(qos.IOTune, "write_iops_sec_max"),
+		})
+	}
+
+	state.ID = types.StringValue("qos-disks")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *qosDisksDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
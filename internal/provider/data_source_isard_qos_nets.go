@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &qosNetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &qosNetsDataSource{}
+)
+
+// NewQoSNetsDataSource is a helper function to simplify the provider implementation.
+func NewQoSNetsDataSource() datasource.DataSource {
+	return &qosNetsDataSource{}
+}
+
+// qosNetsDataSource is the data source implementation.
+type qosNetsDataSource struct {
+	client *client.Client
+}
+
+// qosNetsDataSourceModel maps the data source schema data.
+type qosNetsDataSourceModel struct {
+	ID      types.String        `tfsdk:"id"`
+	Filter  *qosNetFilterModel  `tfsdk:"filter"`
+	QoSNets []qosNetDetailModel `tfsdk:"qos_nets"`
+}
+
+// qosNetFilterModel maps the filter schema.
+type qosNetFilterModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// qosNetDetailModel maps individual QoS profile details.
+type qosNetDetailModel struct {
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	AverageDownload types.Int64  `tfsdk:"average_download"`
+	AverageUpload   types.Int64  `tfsdk:"average_upload"`
+	PeakDownload    types.Int64  `tfsdk:"peak_download"`
+	PeakUpload      types.Int64  `tfsdk:"peak_upload"`
+	BurstDownload   types.Int64  `tfsdk:"burst_download"`
+	BurstUpload     types.Int64  `tfsdk:"burst_upload"`
+}
+
+// Metadata returns the data source type name.
+func (d *qosNetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_nets"
+}
+
+// Schema defines the schema for the data source.
+func (d *qosNetsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene una lista de perfiles QoS de red (solo administradores). Permite filtrar por nombre.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar perfiles QoS.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre del perfil (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+				},
+			},
+			"qos_nets": schema.ListNestedAttribute{
+				Description: "Lista de perfiles QoS de red encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único del perfil QoS.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre del perfil QoS.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción del perfil QoS.",
+							Computed:    true,
+						},
+						"average_download": schema.Int64Attribute{
+							Description: "Velocidad media de descarga en KB/s.",
+							Computed:    true,
+						},
+						"average_upload": schema.Int64Attribute{
+							Description: "Velocidad media de subida en KB/s.",
+							Computed:    true,
+						},
+						"peak_download": schema.Int64Attribute{
+							Description: "Velocidad pico de descarga en KB/s.",
+							Computed:    true,
+						},
+						"peak_upload": schema.Int64Attribute{
+							Description: "Velocidad pico de subida en KB/s.",
+							Computed:    true,
+						},
+						"burst_download": schema.Int64Attribute{
+							Description: "Ráfaga de descarga en KB.",
+							Computed:    true,
+						},
+						"burst_upload": schema.Int64Attribute{
+							Description: "Ráfaga de subida en KB.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *qosNetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state qosNetsDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Obtener todos los perfiles QoS
+	qosNets, err := d.client.ListQoSNets()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo perfiles QoS de red",
+			"No se pudo obtener la lista de perfiles QoS: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.QoSNets = []qosNetDetailModel{}
+	for _, qos := range qosNets {
+		if state.Filter != nil && state.Filter.Name.ValueString() != "" && !containsIgnoreCase(qos.Name, state.Filter.Name.ValueString()) {
+			continue
+		}
+
+		state.QoSNets = append(state.QoSNets, qosNetDetailModel{
+			ID:              types.StringValue(qos.ID),
+			Name:            types.StringValue(qos.Name),
+			Description:     types.StringValue(qos.Description),
+			AverageDownload: bandwidthFromAPI(qos.Bandwidth, "average_download"),
+			AverageUpload:   bandwidthFromAPI(qos.Bandwidth, "average_upload"),
+			PeakDownload:    bandwidthFromAPI(qos.Bandwidth, "peak_download"),
+			PeakUpload:      bandwidthFromAPI(qos.Bandwidth, "peak_upload"),
+			BurstDownload:   bandwidthFromAPI(qos.Bandwidth, "burst_download"),
+			BurstUpload:     bandwidthFromAPI(qos.Bandwidth, "burst_upload"),
+		})
+	}
+
+	state.ID = types.StringValue("qos-nets")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *qosNetsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
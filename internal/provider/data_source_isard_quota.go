@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &quotaDataSource{}
+	_ datasource.DataSourceWithConfigure = &quotaDataSource{}
+)
+
+// NewQuotaDataSource is a helper function to simplify the provider implementation.
+func NewQuotaDataSource() datasource.DataSource {
+	return &quotaDataSource{}
+}
+
+// quotaDataSource is the data source implementation.
+type quotaDataSource struct {
+	client *client.Client
+}
+
+// quotaDataSourceModel maps the data source schema data.
+type quotaDataSourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Kind     types.String `tfsdk:"kind"`
+	TargetID types.String `tfsdk:"target_id"`
+	Quota    *quotaModel  `tfsdk:"quota"`
+	Limits   *quotaModel  `tfsdk:"limits"`
+	Used     *quotaModel  `tfsdk:"used"`
+}
+
+// Metadata returns the data source type name.
+func (d *quotaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota"
+}
+
+// Schema defines the schema for the data source.
+func (d *quotaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene la cuota aplicada y el consumo actual de un usuario, grupo o categoría (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador con formato <kind>/<target_id>.",
+				Computed:    true,
+			},
+			"kind": schema.StringAttribute{
+				Description: "Tipo de objeto (user, group, category).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(quotaKinds...),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Description: "ID del usuario, grupo o categoría.",
+				Required:    true,
+			},
+			"quota":  quotaDataSourceAttributeSchema("Cuota aplicada (null si no hay cuota)."),
+			"limits": quotaDataSourceAttributeSchema("Límites aplicados (null si no hay límites)."),
+			"used":   quotaDataSourceAttributeSchema("Consumo actual, con las mismas claves que la cuota."),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *quotaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state quotaDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	usage, err := d.client.GetQuotaUsage(state.Kind.ValueString(), state.TargetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo cuota",
+			"No se pudo obtener la cuota de "+state.Kind.ValueString()+" "+state.TargetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(state.Kind.ValueString() + "/" + state.TargetID.ValueString())
+	state.Quota = quotaFromAPI(usage.Quota)
+	state.Limits = quotaFromAPI(usage.Limits)
+	state.Used = quotaFromAPI(usage.Used)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *quotaDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
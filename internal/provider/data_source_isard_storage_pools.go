@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &storagePoolsDataSource{}
+	_ datasource.DataSourceWithConfigure = &storagePoolsDataSource{}
+)
+
+// NewStoragePoolsDataSource is a helper function to simplify the provider implementation.
+func NewStoragePoolsDataSource() datasource.DataSource {
+	return &storagePoolsDataSource{}
+}
+
+// storagePoolsDataSource is the data source implementation.
+type storagePoolsDataSource struct {
+	client *client.Client
+}
+
+// storagePoolsDataSourceModel maps the data source schema data.
+type storagePoolsDataSourceModel struct {
+	ID           types.String             `tfsdk:"id"`
+	Filter       *storagePoolFilterModel  `tfsdk:"filter"`
+	StoragePools []storagePoolDetailModel `tfsdk:"storage_pools"`
+}
+
+// storagePoolFilterModel maps the filter schema.
+type storagePoolFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	Category types.String `tfsdk:"category"`
+}
+
+// storagePoolDetailModel maps individual storage pool details.
+type storagePoolDetailModel struct {
+	ID             types.String  `tfsdk:"id"`
+	Name           types.String  `tfsdk:"name"`
+	Description    types.String  `tfsdk:"description"`
+	Mountpoint     types.String  `tfsdk:"mountpoint"`
+	Categories     types.List    `tfsdk:"categories"`
+	Enabled        types.Bool    `tfsdk:"enabled"`
+	ReadOnly       types.Bool    `tfsdk:"read_only"`
+	SizeBytes      types.Int64   `tfsdk:"size_bytes"`
+	UsedBytes      types.Int64   `tfsdk:"used_bytes"`
+	AvailableBytes types.Int64   `tfsdk:"available_bytes"`
+	UsedPercent    types.Float64 `tfsdk:"used_percent"`
+}
+
+// Metadata returns the data source type name.
+func (d *storagePoolsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pools"
+}
+
+// Schema defines the schema for the data source.
+func (d *storagePoolsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene la lista de storage pools con su ocupación (solo administradores). Permite filtrar por nombre y categoría.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar storage pools.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre del storage pool (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"category": schema.StringAttribute{
+						Description: "ID de una categoría asignada al storage pool.",
+						Optional:    true,
+					},
+				},
+			},
+			"storage_pools": schema.ListNestedAttribute{
+				Description: "Lista de storage pools encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único del storage pool.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre del storage pool.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "Descripción del storage pool.",
+							Computed:    true,
+						},
+						"mountpoint": schema.StringAttribute{
+							Description: "Punto de montaje del almacenamiento.",
+							Computed:    true,
+						},
+						"categories": schema.ListAttribute{
+							Description: "IDs de las categorías asignadas.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Si el storage pool está habilitado.",
+							Computed:    true,
+						},
+						"read_only": schema.BoolAttribute{
+							Description: "Si el storage pool es de solo lectura.",
+							Computed:    true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							Description: "Tamaño total del almacenamiento en bytes.",
+							Computed:    true,
+						},
+						"used_bytes": schema.Int64Attribute{
+							Description: "Espacio usado en bytes.",
+							Computed:    true,
+						},
+						"available_bytes": schema.Int64Attribute{
+							Description: "Espacio disponible en bytes.",
+							Computed:    true,
+						},
+						"used_percent": schema.Float64Attribute{
+							Description: "Espacio usado en porcentaje.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *storagePoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state storagePoolsDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pools, err := d.client.ListStoragePools()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo storage pools",
+			"No se pudo obtener la lista de storage pools: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.StoragePools = []storagePoolDetailModel{}
+	for _, pool := range pools {
+		if state.Filter != nil {
+			if state.Filter.Name.ValueString() != "" && !containsIgnoreCase(pool.Name, state.Filter.Name.ValueString()) {
+				continue
+			}
+			if category := state.Filter.Category.ValueString(); category != "" && !slices.Contains(pool.Categories, category) {
+				continue
+			}
+		}
+
+		categories, diags := types.ListValueFrom(ctx, types.StringType, pool.Categories)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		detail := storagePoolDetailModel{
+			ID:             types.StringValue(pool.ID),
+			Name:           types.StringValue(pool.Name),
+			Description:    types.StringValue(pool.Description),
+			Mountpoint:     types.StringValue(pool.Mountpoint),
+			Categories:     categories,
+			Enabled:        types.BoolValue(pool.Enabled),
+			ReadOnly:       types.BoolValue(pool.ReadOnly),
+			SizeBytes:      types.Int64Null(),
+			UsedBytes:      types.Int64Null(),
+			AvailableBytes: types.Int64Null(),
+			UsedPercent:    types.Float64Null(),
+		}
+
+		// Sin estadísticas (por ejemplo, con el almacenamiento sin montar) la ocupación queda a null
+		if pool.Usage != nil {
+			detail.SizeBytes = types.Int64Value(int64(pool.Usage.Size))
+			detail.UsedBytes = types.Int64Value(int64(pool.Usage.Used))
+			detail.AvailableBytes = types.Int64Value(int64(pool.Usage.Available))
+			if pool.Usage.Size > 0 {
+				detail.UsedPercent = types.Float64Value(pool.Usage.Used * 100 / pool.Usage.Size)
+			}
+		}
+
+		state.StoragePools = append(state.StoragePools, detail)
+	}
+
+	state.ID = types.StringValue("storage-pools")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *storagePoolsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+// NewUsersDataSource is a helper function to simplify the provider implementation.
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+// usersDataSource is the data source implementation.
+type usersDataSource struct {
+	client *client.Client
+}
+
+// usersDataSourceModel maps the data source schema data.
+type usersDataSourceModel struct {
+	ID     types.String      `tfsdk:"id"`
+	Filter *userFilterModel  `tfsdk:"filter"`
+	Users  []userDetailModel `tfsdk:"users"`
+}
+
+// userFilterModel maps the filter schema.
+type userFilterModel struct {
+	Name     types.String `tfsdk:"name"`
+	Role     types.String `tfsdk:"role"`
+	Category types.String `tfsdk:"category"`
+	Group    types.String `tfsdk:"group"`
+	Active   types.Bool   `tfsdk:"active"`
+}
+
+// userDetailModel maps individual user details.
+type userDetailModel struct {
+	ID              types.String `tfsdk:"id"`
+	Provider        types.String `tfsdk:"auth_provider"`
+	Username        types.String `tfsdk:"username"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	Category        types.String `tfsdk:"category"`
+	Group           types.String `tfsdk:"group"`
+	SecondaryGroups types.List   `tfsdk:"secondary_groups"`
+	Active          types.Bool   `tfsdk:"active"`
+}
+
+// Metadata returns the data source type name.
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Obtiene una lista de usuarios (solo administradores). Permite filtrar por nombre, rol, categoría, grupo y estado.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Identificador del data source.",
+				Computed:    true,
+			},
+			"filter": schema.SingleNestedAttribute{
+				Description: "Filtros para buscar usuarios.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "Nombre de usuario o nombre completo (búsqueda parcial, case-insensitive).",
+						Optional:    true,
+					},
+					"role": schema.StringAttribute{
+						Description: "Rol del usuario (coincidencia exacta).",
+						Optional:    true,
+					},
+					"category": schema.StringAttribute{
+						Description: "ID de la categoría (coincidencia exacta).",
+						Optional:    true,
+					},
+					"group": schema.StringAttribute{
+						Description: "ID del grupo principal o secundario (coincidencia exacta).",
+						Optional:    true,
+					},
+					"active": schema.BoolAttribute{
+						Description: "Estado del usuario (habilitado o no).",
+						Optional:    true,
+					},
+				},
+			},
+			"users": schema.ListNestedAttribute{
+				Description: "Lista de usuarios encontrados.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "ID único del usuario.",
+							Computed:    true,
+						},
+						"auth_provider": schema.StringAttribute{
+							Description: "Proveedor de autenticación.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Nombre de usuario.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "Nombre completo.",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "Correo electrónico.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Rol del usuario.",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "ID de la categoría.",
+							Computed:    true,
+						},
+						"group": schema.StringAttribute{
+							Description: "ID del grupo principal.",
+							Computed:    true,
+						},
+						"secondary_groups": schema.ListAttribute{
+							Description: "IDs de los grupos secundarios.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"active": schema.BoolAttribute{
+							Description: "Si el usuario está habilitado.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state usersDataSourceModel
+
+	// Get configuration
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Obtener todos los usuarios
+	users, err := d.client.GetUsers()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo usuarios",
+			"No se pudo obtener la lista de usuarios: "+err.Error(),
+		)
+		return
+	}
+
+	// Mapear a la estructura del state aplicando los filtros
+	state.Users = []userDetailModel{}
+	for _, user := range users {
+		if !matchesUserFilter(user, state.Filter) {
+			continue
+		}
+
+		secondaryGroups := user.SecondaryGroups
+		if secondaryGroups == nil {
+			secondaryGroups = []string{}
+		}
+		secondaryGroupsList, diags := types.ListValueFrom(ctx, types.StringType, secondaryGroups)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		state.Users = append(state.Users, userDetailModel{
+			ID:              types.StringValue(user.ID),
+			Provider:        types.StringValue(user.Provider),
+			Username:        types.StringValue(user.Username),
+			Name:            types.StringValue(user.Name),
+			Email:           types.StringValue(user.Email),
+			Role:            types.StringValue(user.Role),
+			Category:        types.StringValue(user.Category),
+			Group:           types.StringValue(user.Group),
+			SecondaryGroups: secondaryGroupsList,
+			Active:          types.BoolValue(user.Active),
+		})
+	}
+
+	state.ID = types.StringValue("users")
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// matchesUserFilter indica si un usuario cumple todos los filtros indicados
+func matchesUserFilter(user client.User, filter *userFilterModel) bool {
+	if filter == nil {
+		return true
+	}
+
+	if name := filter.Name.ValueString(); name != "" {
+		if !containsIgnoreCase(user.Username, name) && !containsIgnoreCase(user.Name, name) {
+			return false
+		}
+	}
+
+	if role := filter.Role.ValueString(); role != "" && user.Role != role {
+		return false
+	}
+
+	if category := filter.Category.ValueString(); category != "" && user.Category != category {
+		return false
+	}
+
+	if group := filter.Group.ValueString(); group != "" && user.Group != group {
+		found := false
+		for _, secondary := range user.SecondaryGroups {
+			if secondary == group {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if !filter.Active.IsNull() && user.Active != filter.Active.ValueBool() {
+		return false
+	}
+
+	return true
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *usersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
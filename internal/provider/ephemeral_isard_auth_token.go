@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &authTokenEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &authTokenEphemeralResource{}
+)
+
+// NewAuthTokenEphemeralResource is a helper function to simplify the provider implementation.
+func NewAuthTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &authTokenEphemeralResource{}
+}
+
+// authTokenEphemeralResource is the ephemeral resource implementation.
+type authTokenEphemeralResource struct {
+	client *client.Client
+}
+
+// authTokenEphemeralResourceModel maps the ephemeral resource schema data.
+type authTokenEphemeralResourceModel struct {
+	CategoryID types.String `tfsdk:"category_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Token      types.String `tfsdk:"token"`
+	ExpiresAt  types.String `tfsdk:"expires_at"`
+}
+
+// Metadata returns the ephemeral resource type name.
+func (e *authTokenEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_auth_token"
+}
+
+// Schema defines the schema for the ephemeral resource.
+func (e *authTokenEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Inicia sesión en Isard VDI y devuelve un token JWT de corta duración. El token no se guarda en el plan ni en el estado.",
+		Attributes: map[string]schema.Attribute{
+			"category_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID de la categoría del usuario (por defecto: default)",
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Usuario con el que se inicia sesión",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Contraseña del usuario",
+			},
+			"token": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token JWT obtenido",
+			},
+			"expires_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Caducidad del token en formato RFC 3339 (null si el token no la indica)",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the ephemeral resource.
+func (e *authTokenEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	e.client = client
+}
+
+// Open inicia sesión con las credenciales indicadas y devuelve el token.
+func (e *authTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data authTokenEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	categoryID := data.CategoryID.ValueString()
+	if categoryID == "" {
+		categoryID = "default"
+	}
+
+	// Open puede ejecutarse antes de configurar el provider (por ejemplo, si su
+	// configuración depende de valores que no se conocen hasta el apply)
+	if e.client == nil {
+		resp.Diagnostics.AddError(
+			"Provider no configurado",
+			"No se puede obtener el token porque el provider isard todavía no está configurado. Comprueba que su configuración no depende de valores desconocidos durante el plan.",
+		)
+		return
+	}
+
+	// Cliente propio para no sustituir el token con el que trabaja el provider
+	c := client.NewClient(e.client.HostURL, "")
+	err := c.SignIn(client.AuthConfig{
+		Method:     "form",
+		CategoryID: categoryID,
+		Username:   data.Username.ValueString(),
+		Password:   data.Password.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo token",
+			"No se pudo iniciar sesión como "+data.Username.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	data.CategoryID = types.StringValue(categoryID)
+	data.Token = types.StringValue(c.Token)
+	data.ExpiresAt = types.StringNull()
+	if expiry, err := client.TokenExpiry(c.Token); err == nil {
+		data.ExpiresAt = types.StringValue(expiry.Format(time.RFC3339))
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
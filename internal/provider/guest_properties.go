@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// viewerTypes son los tipos de viewer que acepta Isard en guest_properties
+var viewerTypes = []string{"browser_vnc", "file_spice", "browser_rdp", "file_rdpgw", "file_rdpvpn"}
+
+// viewerModel representa un viewer habilitado y sus opciones
+type viewerModel struct {
+	Type    types.String `tfsdk:"type"`
+	Options types.Map    `tfsdk:"options"`
+}
+
+// credentialsModel representa las credenciales RDP del sistema invitado
+type credentialsModel struct {
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+}
+
+// viewerBlockSchema devuelve el bloque "viewer" compartido por isard_vm e isard_deployment
+func viewerBlockSchema() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Viewer habilitado para el desktop. Se puede repetir el bloque para habilitar varios viewers. Si no se especifica, se usan los del template.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Tipo de viewer (browser_vnc, file_spice, browser_rdp, file_rdpgw, file_rdpvpn)",
+					Validators: []validator.String{
+						stringvalidator.OneOf(viewerTypes...),
+					},
+				},
+				"options": schema.MapAttribute{
+					ElementType:         types.StringType,
+					Optional:            true,
+					MarkdownDescription: "Opciones específicas del viewer",
+				},
+			},
+		},
+	}
+}
+
+// fullscreenAttributeSchema devuelve el atributo "fullscreen" de guest_properties
+func fullscreenAttributeSchema() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		MarkdownDescription: "Si los viewers se abren a pantalla completa (por defecto usa el del template)",
+	}
+}
+
+// credentialsAttributeSchema devuelve el atributo "credentials" de guest_properties
+func credentialsAttributeSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Credenciales del sistema invitado utilizadas por los viewers RDP",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Usuario RDP del sistema invitado",
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Contraseña RDP del sistema invitado (write-only, no se guarda en el estado)",
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Versión de la contraseña. Cámbiala para volver a enviar `password` en un update",
+			},
+		},
+	}
+}
+
+// buildGuestProperties construye el mapa guest_properties para la API.
+// Devuelve nil si no se ha configurado ningún valor.
+func buildGuestProperties(ctx context.Context, viewers []viewerModel, legacyViewers []string, fullscreen types.Bool, credentials *credentialsModel, password types.String) (map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	guestProperties := make(map[string]interface{})
+
+	if len(viewers) > 0 || len(legacyViewers) > 0 {
+		viewersMap := make(map[string]interface{})
+		for _, viewer := range legacyViewers {
+			viewersMap[viewer] = map[string]interface{}{"options": nil}
+		}
+		for _, viewer := range viewers {
+			var options interface{}
+			if !viewer.Options.IsNull() && !viewer.Options.IsUnknown() {
+				var optionsMap map[string]string
+				diags.Append(viewer.Options.ElementsAs(ctx, &optionsMap, false)...)
+				if diags.HasError() {
+					return nil, diags
+				}
+				options = optionsMap
+			}
+			viewersMap[viewer.Type.ValueString()] = map[string]interface{}{"options": options}
+		}
+		guestProperties["viewers"] = viewersMap
+	}
+
+	if !fullscreen.IsNull() && !fullscreen.IsUnknown() {
+		guestProperties["fullscreen"] = fullscreen.ValueBool()
+	}
+
+	if credentials != nil {
+		credentialsMap := make(map[string]interface{})
+		if !credentials.Username.IsNull() && !credentials.Username.IsUnknown() {
+			credentialsMap["username"] = credentials.Username.ValueString()
+		}
+		if !password.IsNull() && !password.IsUnknown() {
+			credentialsMap["password"] = password.ValueString()
+		}
+		if len(credentialsMap) > 0 {
+			guestProperties["credentials"] = credentialsMap
+		}
+	}
+
+	if len(guestProperties) == 0 {
+		return nil, diags
+	}
+
+	return guestProperties, diags
+}
+
+// viewersFromGuestProperties convierte los viewers devueltos por la API al modelo de Terraform.
+// Se respeta el orden de current para no generar diferencias solo por orden.
+func viewersFromGuestProperties(ctx context.Context, guestProperties map[string]interface{}, current []viewerModel) ([]viewerModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	viewersMap, ok := guestProperties["viewers"].(map[string]interface{})
+	if !ok {
+		return nil, diags
+	}
+
+	order := make([]string, 0, len(current)+len(viewerTypes))
+	for _, viewer := range current {
+		order = append(order, viewer.Type.ValueString())
+	}
+	order = append(order, viewerTypes...)
+
+	seen := make(map[string]bool)
+	viewers := make([]viewerModel, 0, len(viewersMap))
+	for _, viewerType := range order {
+		raw, ok := viewersMap[viewerType]
+		if !ok || seen[viewerType] {
+			continue
+		}
+		seen[viewerType] = true
+
+		viewer := viewerModel{
+			Type:    types.StringValue(viewerType),
+			Options: types.MapNull(types.StringType),
+		}
+		if viewerMap, ok := raw.(map[string]interface{}); ok {
+			if options, ok := viewerMap["options"].(map[string]interface{}); ok {
+				optionsMap := make(map[string]string, len(options))
+				for k, v := range options {
+					if s, ok := v.(string); ok {
+						optionsMap[k] = s
+					}
+				}
+				var d diag.Diagnostics
+				viewer.Options, d = types.MapValueFrom(ctx, types.StringType, optionsMap)
+				diags.Append(d...)
+			}
+		}
+		viewers = append(viewers, viewer)
+	}
+
+	return viewers, diags
+}
+
+// guestPropertiesDefaults son los valores que aplica Isard cuando el template
+// no define guest_properties. Las credenciales no tienen valor por defecto:
+// si el template no las define, se deja que Isard conserve las suyas.
+var guestPropertiesDefaults = map[string]interface{}{
+	"viewers": map[string]interface{}{
+		"browser_vnc": map[string]interface{}{"options": nil},
+		"file_spice":  map[string]interface{}{"options": nil},
+	},
+	"fullscreen": false,
+}
+
+// managedGuestProperties indica qué guest_properties se gestionan desde Terraform
+type managedGuestProperties struct {
+	Viewers     bool
+	Fullscreen  bool
+	Credentials bool
+}
+
+// removedGuestProperties devuelve las claves de guest_properties que estaban
+// gestionadas en el estado y ya no están configuradas en el plan
+func removedGuestProperties(plan, state managedGuestProperties) []string {
+	var removed []string
+	if state.Viewers && !plan.Viewers {
+		removed = append(removed, "viewers")
+	}
+	if state.Fullscreen && !plan.Fullscreen {
+		removed = append(removed, "fullscreen")
+	}
+	if state.Credentials && !plan.Credentials {
+		removed = append(removed, "credentials")
+	}
+	return removed
+}
+
+// resetGuestProperties añade a guestProperties el valor del template (o el de
+// Isard por defecto) de cada clave eliminada, para que Isard deje de aplicar
+// el valor gestionado anteriormente. Las claves sin valor en el template ni
+// por defecto no se envían. templateGuestProps puede ser nil.
+func resetGuestProperties(guestProperties, templateGuestProps map[string]interface{}, removed []string) map[string]interface{} {
+	if len(removed) == 0 {
+		return guestProperties
+	}
+	if guestProperties == nil {
+		guestProperties = make(map[string]interface{})
+	}
+	for _, key := range removed {
+		if value, ok := templateGuestProps[key]; ok && value != nil {
+			guestProperties[key] = value
+		} else if value, ok := guestPropertiesDefaults[key]; ok {
+			guestProperties[key] = value
+		}
+	}
+	if len(guestProperties) == 0 {
+		return nil
+	}
+	return guestProperties
+}
+
+// credentialsPasswordChanged indica si hay que enviar la contraseña write-only:
+// al configurar las credenciales o cuando cambia password_version
+func credentialsPasswordChanged(plan, state *credentialsModel) bool {
+	if plan == nil {
+		return false
+	}
+	if state == nil {
+		return true
+	}
+	return !plan.PasswordVersion.Equal(state.PasswordVersion)
+}
+
+// refreshGuestProperties actualiza viewers, fullscreen y credentials.username
+// con los valores de la API, solo si están gestionados desde Terraform
+func refreshGuestProperties(ctx context.Context, guestProperties map[string]interface{}, viewers *[]viewerModel, fullscreen *types.Bool, credentials *credentialsModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if len(*viewers) > 0 {
+		refreshed, d := viewersFromGuestProperties(ctx, guestProperties, *viewers)
+		diags.Append(d...)
+		if refreshed != nil {
+			*viewers = refreshed
+		}
+	}
+	if !fullscreen.IsNull() {
+		if value, ok := guestProperties["fullscreen"].(bool); ok {
+			*fullscreen = types.BoolValue(value)
+		}
+	}
+	if credentials != nil && !credentials.Username.IsNull() {
+		if credentialsMap, ok := guestProperties["credentials"].(map[string]interface{}); ok {
+			if username, ok := credentialsMap["username"].(string); ok {
+				credentials.Username = types.StringValue(username)
+			}
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// interfaceKinds son los tipos de interfaz de red que acepta Isard
+var interfaceKinds = []string{"bridge", "network", "ovs", "personal"}
+
+// interfaceModels son los modelos de tarjeta de red que acepta Isard
+var interfaceModels = []string{"virtio", "e1000", "rtl8139"}
+
+const (
+	// vlanMin y vlanMax delimitan los IDs de VLAN utilizables
+	vlanMin = 1
+	vlanMax = 4094
+	// wireguardVLAN es la VLAN OVS que Isard reserva para la red wireguard
+	wireguardVLAN = 4095
+)
+
+var (
+	// linuxIfnameRegexp valida nombres de interfaz Linux (máximo 15 caracteres)
+	linuxIfnameRegexp = regexp.MustCompile(`^[A-Za-z0-9_.:-]{1,15}$`)
+	// libvirtNetworkRegexp valida nombres de red libvirt
+	libvirtNetworkRegexp = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// bridgeInterfaceModel representa los campos específicos de kind = "bridge"
+type bridgeInterfaceModel struct {
+	Interface types.String `tfsdk:"interface"`
+}
+
+// networkInterfaceKindModel representa los campos específicos de kind = "network"
+type networkInterfaceKindModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+// ovsInterfaceModel representa los campos específicos de kind = "ovs"
+type ovsInterfaceModel struct {
+	VLANID types.Int64 `tfsdk:"vlan_id"`
+}
+
+// personalInterfaceModel representa los campos específicos de kind = "personal"
+type personalInterfaceModel struct {
+	VLANRange *vlanRangeModel `tfsdk:"vlan_range"`
+}
+
+// vlanRangeModel representa un rango de VLANs (ambos extremos incluidos)
+type vlanRangeModel struct {
+	Start types.Int64 `tfsdk:"start"`
+	End   types.Int64 `tfsdk:"end"`
+}
+
+// interfaceKindAttributes devuelve los atributos anidados específicos de cada tipo de interfaz
+func interfaceKindAttributes() map[string]schema.Attribute {
+	vlanAttribute := func(description string, max int64) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Required:            true,
+			MarkdownDescription: description,
+			Validators: []validator.Int64{
+				int64validator.Between(vlanMin, max),
+			},
+		}
+	}
+
+	return map[string]schema.Attribute{
+		"bridge": schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Campos de `kind = \"bridge\"`. Alternativa tipada a `ifname`",
+			Attributes: map[string]schema.Attribute{
+				"interface": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Interfaz Linux del host conectada al bridge",
+				},
+			},
+		},
+		"network": schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Campos de `kind = \"network\"`. Alternativa tipada a `ifname`",
+			Attributes: map[string]schema.Attribute{
+				"name": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Nombre de la red libvirt",
+				},
+			},
+		},
+		"ovs": schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Campos de `kind = \"ovs\"`. Alternativa tipada a `ifname`",
+			Attributes: map[string]schema.Attribute{
+				"vlan_id": vlanAttribute("ID de VLAN (1-4094, o 4095 para la red wireguard)", wireguardVLAN),
+			},
+		},
+		"personal": schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: "Campos de `kind = \"personal\"`. Alternativa tipada a `ifname`",
+			Attributes: map[string]schema.Attribute{
+				"vlan_range": schema.SingleNestedAttribute{
+					Required:            true,
+					MarkdownDescription: "Rango de VLANs reservado para las redes personales",
+					Attributes: map[string]schema.Attribute{
+						"start": vlanAttribute("Primera VLAN del rango (1-4094)", vlanMax),
+						"end":   vlanAttribute("Última VLAN del rango (1-4094)", vlanMax),
+					},
+				},
+			},
+		},
+	}
+}
+
+// kindSpecific devuelve el tipo configurado mediante un atributo anidado y el
+// ifname que le corresponde. known es false si algún valor aún no se conoce.
+func (m *networkInterfaceResourceModel) kindSpecific() (kind, ifname string, known bool) {
+	switch {
+	case m.Bridge != nil:
+		return "bridge", m.Bridge.Interface.ValueString(), !m.Bridge.Interface.IsUnknown()
+	case m.Network != nil:
+		return "network", m.Network.Name.ValueString(), !m.Network.Name.IsUnknown()
+	case m.OVS != nil:
+		return "ovs", strconv.FormatInt(m.OVS.VLANID.ValueInt64(), 10), !m.OVS.VLANID.IsUnknown()
+	case m.Personal != nil && m.Personal.VLANRange != nil:
+		r := m.Personal.VLANRange
+		return "personal", formatVLANRange(r.Start.ValueInt64(), r.End.ValueInt64()), !r.Start.IsUnknown() && !r.End.IsUnknown()
+	}
+	return "", "", false
+}
+
+// refreshKindSpecific actualiza el atributo anidado gestionado a partir del ifname leído de la API.
+// Si el ifname ya no corresponde al tipo, el atributo se anula para que Terraform muestre la diferencia.
+func (m *networkInterfaceResourceModel) refreshKindSpecific(kind, ifname string) {
+	switch {
+	case m.Bridge != nil:
+		m.Bridge = nil
+		if kind == "bridge" {
+			m.Bridge = &bridgeInterfaceModel{Interface: types.StringValue(ifname)}
+		}
+	case m.Network != nil:
+		m.Network = nil
+		if kind == "network" {
+			m.Network = &networkInterfaceKindModel{Name: types.StringValue(ifname)}
+		}
+	case m.OVS != nil:
+		m.OVS = nil
+		if vlan, err := strconv.ParseInt(ifname, 10, 64); kind == "ovs" && err == nil {
+			m.OVS = &ovsInterfaceModel{VLANID: types.Int64Value(vlan)}
+		}
+	case m.Personal != nil:
+		m.Personal = nil
+		if start, end, err := parseVLANRange(ifname); kind == "personal" && err == nil {
+			m.Personal = &personalInterfaceModel{VLANRange: &vlanRangeModel{
+				Start: types.Int64Value(start),
+				End:   types.Int64Value(end),
+			}}
+		}
+	}
+}
+
+// validateInterfaceIfname comprueba que ifname tenga el formato que espera el tipo de interfaz
+func validateInterfaceIfname(kind, ifname string) error {
+	switch kind {
+	case "bridge":
+		if !linuxIfnameRegexp.MatchString(ifname) {
+			return fmt.Errorf("para kind = \"bridge\", ifname debe ser un nombre de interfaz Linux (máximo 15 caracteres alfanuméricos, '_', '.', ':' o '-'); recibido %q", ifname)
+		}
+	case "network":
+		if !libvirtNetworkRegexp.MatchString(ifname) {
+			return fmt.Errorf("para kind = \"network\", ifname debe ser el nombre de una red libvirt; recibido %q", ifname)
+		}
+	case "ovs":
+		vlan, err := strconv.ParseInt(ifname, 10, 64)
+		if err != nil || ((vlan < vlanMin || vlan > vlanMax) && vlan != wireguardVLAN) {
+			return fmt.Errorf("para kind = \"ovs\", ifname debe ser un ID de VLAN entre %d y %d (o %d para wireguard); recibido %q", vlanMin, vlanMax, wireguardVLAN, ifname)
+		}
+	case "personal":
+		if _, _, err := parseVLANRange(ifname); err != nil {
+			return fmt.Errorf("para kind = \"personal\", ifname debe ser un rango de VLANs \"inicio-fin\": %s", err.Error())
+		}
+	}
+	return nil
+}
+
+// validateInterfaceModel comprueba que el modelo de tarjeta sea compatible con el tipo de interfaz
+func validateInterfaceModel(kind, ifname, model string) error {
+	// La red wireguard solo funciona con virtio
+	if kind == "ovs" && ifname == strconv.Itoa(wireguardVLAN) && model != "virtio" {
+		return fmt.Errorf("la interfaz wireguard (ovs, VLAN %d) solo admite model = \"virtio\"; recibido %q", wireguardVLAN, model)
+	}
+	return nil
+}
+
+// formatVLANRange serializa un rango de VLANs con el formato de ifname de Isard
+func formatVLANRange(start, end int64) string {
+	return fmt.Sprintf("%d-%d", start, end)
+}
+
+// parseVLANRange interpreta un rango de VLANs con formato "inicio-fin"
+func parseVLANRange(value string) (int64, int64, error) {
+	parts := strings.SplitN(value, "-", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("%q no tiene formato \"inicio-fin\"", value)
+	}
+
+	start, errStart := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
+	end, errEnd := strconv.ParseInt(strings.TrimSpace(parts[1]), 10, 64)
+	if errStart != nil || errEnd != nil {
+		return 0, 0, fmt.Errorf("%q no tiene formato \"inicio-fin\"", value)
+	}
+	if start < vlanMin || end > vlanMax || start > end {
+		return 0, 0, fmt.Errorf("el rango %q debe estar entre %d y %d con inicio <= fin", value, vlanMin, vlanMax)
+	}
+
+	return start, end, nil
+}
+
+// interfaceVLANs devuelve el rango de VLANs que ocupa una interfaz ovs o personal.
+// ok es false para otros tipos, para wireguard y para valores de ifname no válidos.
+func interfaceVLANs(kind, ifname string) (start, end int64, ok bool) {
+	switch kind {
+	case "ovs":
+		vlan, err := strconv.ParseInt(ifname, 10, 64)
+		if err != nil || vlan < vlanMin || vlan > vlanMax {
+			return 0, 0, false
+		}
+		return vlan, vlan, true
+	case "personal":
+		start, end, err := parseVLANRange(ifname)
+		return start, end, err == nil
+	}
+	return 0, 0, false
+}
+
+// findVLANOverlaps devuelve las interfaces (salvo la propia) cuyas VLANs se solapan con el rango indicado
+func findVLANOverlaps(interfaces []client.NetworkInterface, id string, start, end int64) []string {
+	var overlaps []string
+	for _, iface := range interfaces {
+		if iface.ID == id {
+			continue
+		}
+		otherStart, otherEnd, ok := interfaceVLANs(iface.Kind, iface.Ifname)
+		if !ok || otherEnd < start || otherStart > end {
+			continue
+		}
+		overlaps = append(overlaps, fmt.Sprintf("%s (%s %s)", iface.ID, iface.Kind, iface.Ifname))
+	}
+	return overlaps
+}
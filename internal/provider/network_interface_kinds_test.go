@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+func TestParseVLANRange(t *testing.T) {
+	tests := []struct {
+		value      string
+		start, end int64
+		wantErr    bool
+	}{
+		{value: "100-200", start: 100, end: 200},
+		{value: " 1 - 4094 ", start: 1, end: 4094},
+		{value: "300-300", start: 300, end: 300},
+		{value: "200-100", wantErr: true},
+		{value: "0-10", wantErr: true},
+		{value: "10-4095", wantErr: true},
+		{value: "100", wantErr: true},
+		{value: "a-b", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			start, end, err := parseVLANRange(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseVLANRange(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && (start != tt.start || end != tt.end) {
+				t.Errorf("parseVLANRange(%q) = %d, %d; want %d, %d", tt.value, start, end, tt.start, tt.end)
+			}
+		})
+	}
+}
+
+func TestFindVLANOverlaps(t *testing.T) {
+	interfaces := []client.NetworkInterface{
+		{ID: "vlan-100", Kind: "ovs", Ifname: "100"},
+		{ID: "personal-a", Kind: "personal", Ifname: "200-299"},
+		{ID: "wireguard", Kind: "ovs", Ifname: "4095"},
+		{ID: "bridge", Kind: "bridge", Ifname: "br0"},
+		{ID: "roto", Kind: "personal", Ifname: "abc"},
+	}
+
+	tests := []struct {
+		name       string
+		id         string
+		start, end int64
+		want       []string
+	}{
+		{name: "sin solapamiento", id: "nueva", start: 101, end: 199},
+		{name: "VLAN ovs", id: "nueva", start: 100, end: 100, want: []string{"vlan-100 (ovs 100)"}},
+		{name: "rango personal", id: "nueva", start: 250, end: 250, want: []string{"personal-a (personal 200-299)"}},
+		{name: "varias", id: "nueva", start: 50, end: 200, want: []string{"vlan-100 (ovs 100)", "personal-a (personal 200-299)"}},
+		{name: "se ignora la propia", id: "vlan-100", start: 100, end: 100},
+		{name: "wireguard no cuenta", id: "nueva", start: 4000, end: 4094},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := findVLANOverlaps(interfaces, tt.id, tt.start, tt.end)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findVLANOverlaps = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
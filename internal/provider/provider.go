@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"fmt"
+	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -17,6 +19,7 @@ import (
 
 // Ensure IsardProvider satisfies various provider interfaces.
 var _ provider.Provider = &IsardProvider{}
+var _ provider.ProviderWithEphemeralResources = &IsardProvider{}
 
 // IsardProvider defines the provider implementation.
 type IsardProvider struct {
@@ -34,6 +37,15 @@ type IsardProviderModel struct {
 	Token       types.String `tfsdk:"token"`
 	Username    types.String `tfsdk:"username"`
 	Password    types.String `tfsdk:"password"`
+
+	OIDCTokenURL     types.String `tfsdk:"oidc_token_url"`
+	OIDCClientID     types.String `tfsdk:"oidc_client_id"`
+	OIDCClientSecret types.String `tfsdk:"oidc_client_secret"`
+	OIDCScope        types.String `tfsdk:"oidc_scope"`
+
+	ExecCommand types.String `tfsdk:"exec_command"`
+	ExecArgs    types.List   `tfsdk:"exec_args"`
+	ExecEnv     types.Map    `tfsdk:"exec_env"`
 }
 
 func New(version string) func() provider.Provider {
@@ -65,10 +77,10 @@ func (p *IsardProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Sensitive:           true,
 			},
 			"auth_method": schema.StringAttribute{
-				MarkdownDescription: "Authentication method to use (token or credentials)",
+				MarkdownDescription: "Authentication method to use: `token`, `form` (username and password), `oidc_client_credentials` or `exec`",
 				Optional:            true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("token", "form"),
+					stringvalidator.OneOf("token", "form", "oidc_client_credentials", "exec"),
 				},
 			},
 			"cathegory_id": schema.StringAttribute{
@@ -84,6 +96,40 @@ func (p *IsardProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"oidc_token_url": schema.StringAttribute{
+				MarkdownDescription: "OAuth 2.0 token endpoint (https) used by the `oidc_client_credentials` method. Its TLS certificate is always verified",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^https://`), "must be an https URL"),
+				},
+			},
+			"oidc_client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID for the `oidc_client_credentials` method",
+				Optional:            true,
+			},
+			"oidc_client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret for the `oidc_client_credentials` method",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"oidc_scope": schema.StringAttribute{
+				MarkdownDescription: "Space-separated scopes requested by the `oidc_client_credentials` method",
+				Optional:            true,
+			},
+			"exec_command": schema.StringAttribute{
+				MarkdownDescription: "Command run by the `exec` method. It must print the token, or a JSON object with `status.token`, `token` or `access_token`, to stdout",
+				Optional:            true,
+			},
+			"exec_args": schema.ListAttribute{
+				MarkdownDescription: "Arguments for `exec_command`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"exec_env": schema.MapAttribute{
+				MarkdownDescription: "Extra environment variables for `exec_command`",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -123,19 +169,59 @@ func (p *IsardProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		}
 	}
 
+	if data.AuthMethod.ValueString() == "oidc_client_credentials" {
+		if data.OIDCTokenURL.IsNull() || data.OIDCClientID.IsNull() || data.OIDCClientSecret.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				"When using 'oidc_client_credentials' authentication method, 'oidc_token_url', 'oidc_client_id' and 'oidc_client_secret' must be provided.",
+			)
+			return
+		}
+	}
+
+	if data.AuthMethod.ValueString() == "exec" {
+		if data.ExecCommand.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid Configuration",
+				"When using 'exec' authentication method, 'exec_command' must be provided.",
+			)
+			return
+		}
+	}
+
+	var execArgs []string
+	if !data.ExecArgs.IsNull() && !data.ExecArgs.IsUnknown() {
+		resp.Diagnostics.Append(data.ExecArgs.ElementsAs(ctx, &execArgs, false)...)
+	}
+	var execEnv map[string]string
+	if !data.ExecEnv.IsNull() && !data.ExecEnv.IsUnknown() {
+		resp.Diagnostics.Append(data.ExecEnv.ElementsAs(ctx, &execEnv, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Configuration values are now available.
 
 	// Create the client
 	c := client.NewClient(data.Endpoint.ValueString(), data.Token.ValueString())
 
 	// Authenticate
-	// SignIn manejará "salm" y "form". Si es "token", no hará nada (ya tenemos el token).
-	err := c.SignIn(
-		data.AuthMethod.ValueString(),
-		data.CathegoryID.ValueString(),
-		data.Username.ValueString(),
-		data.Password.ValueString(),
-	)
+	// SignIn obtiene el token con "form", "oidc_client_credentials" o "exec".
+	// Si es "token", no hará nada (ya tenemos el token).
+	err := c.SignIn(client.AuthConfig{
+		Method:           data.AuthMethod.ValueString(),
+		CategoryID:       data.CathegoryID.ValueString(),
+		Username:         data.Username.ValueString(),
+		Password:         data.Password.ValueString(),
+		OIDCTokenURL:     data.OIDCTokenURL.ValueString(),
+		OIDCClientID:     data.OIDCClientID.ValueString(),
+		OIDCClientSecret: data.OIDCClientSecret.ValueString(),
+		OIDCScope:        data.OIDCScope.ValueString(),
+		ExecCommand:      data.ExecCommand.ValueString(),
+		ExecArgs:         execArgs,
+		ExecEnv:          execEnv,
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -147,6 +233,7 @@ func (p *IsardProvider) Configure(ctx context.Context, req provider.ConfigureReq
 
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
 }
 
 // Resources defines the resources implemented in the provider.
@@ -157,6 +244,17 @@ func (p *IsardProvider) Resources(_ context.Context) []func() resource.Resource
 		NewNetworkResource,
 		NewQoSNetResource,
 		NewNetworkInterfaceResource,
+		NewUserResource,
+		NewGroupResource,
+		NewCategoryResource,
+		NewQuotaResource,
+		NewMediaResource,
+		NewBookingResource,
+		NewVolatileDesktopResource,
+		NewQoSDiskResource,
+		NewHypervisorResource,
+		NewStoragePoolResource,
+		NewAPIKeyResource,
 	}
 }
 
@@ -166,5 +264,24 @@ func (p *IsardProvider) DataSources(_ context.Context) []func() datasource.DataS
 		NewTemplatesDataSource,
 		NewNetworkInterfacesDataSource,
 		NewGroupsDataSource,
+		NewNetworksDataSource,
+		NewQoSNetsDataSource,
+		NewUsersDataSource,
+		NewCategoriesDataSource,
+		NewQuotaDataSource,
+		NewMediaDataSource,
+		NewGPUProfilesDataSource,
+		NewDeploymentDesktopsDataSource,
+		NewDesktopViewerDataSource,
+		NewQoSDisksDataSource,
+		NewHypervisorsDataSource,
+		NewStoragePoolsDataSource,
+	}
+}
+
+// EphemeralResources defines the ephemeral resources implemented in the provider.
+func (p *IsardProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAuthTokenEphemeralResource,
 	}
 }
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// qosBandwidthFields son los campos del objeto bandwidth de un QoS de red
+var qosBandwidthFields = []string{
+	"average_download",
+	"average_upload",
+	"peak_download",
+	"peak_upload",
+	"burst_download",
+	"burst_upload",
+}
+
+// qosNetBandwidthModel representa los valores de ancho de banda escritos con unidades
+type qosNetBandwidthModel struct {
+	AverageDownload types.String `tfsdk:"average_download"`
+	AverageUpload   types.String `tfsdk:"average_upload"`
+	PeakDownload    types.String `tfsdk:"peak_download"`
+	PeakUpload      types.String `tfsdk:"peak_upload"`
+	BurstDownload   types.String `tfsdk:"burst_download"`
+	BurstUpload     types.String `tfsdk:"burst_upload"`
+}
+
+// fields devuelve los valores del modelo indexados por nombre de campo
+func (m *qosNetBandwidthModel) fields() map[string]types.String {
+	if m == nil {
+		return map[string]types.String{}
+	}
+	return map[string]types.String{
+		"average_download": m.AverageDownload,
+		"average_upload":   m.AverageUpload,
+		"peak_download":    m.PeakDownload,
+		"peak_upload":      m.PeakUpload,
+		"burst_download":   m.BurstDownload,
+		"burst_upload":     m.BurstUpload,
+	}
+}
+
+// qosBandwidthAttributeSchema devuelve el esquema del atributo bandwidth con unidades
+func qosBandwidthAttributeSchema() schema.SingleNestedAttribute {
+	rate := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: description + " con unidades (ej. `\"100Mbit\"`, `\"12MB\"`). Sin unidad se interpreta en KB/s",
+		}
+	}
+	size := func(description string) schema.StringAttribute {
+		return schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: description + " con unidades (ej. `\"10MB\"`, `\"80Mbit\"`). Sin unidad se interpreta en KB",
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Valores de ancho de banda con unidades legibles, alternativa a los atributos numéricos en KB/s. Cada valor se convierte a las unidades de libvirt (KB/s o KB) y se refleja en el atributo numérico del mismo nombre",
+		Attributes: map[string]schema.Attribute{
+			"average_download": rate("Velocidad media de descarga"),
+			"average_upload":   rate("Velocidad media de subida"),
+			"peak_download":    rate("Velocidad pico de descarga"),
+			"peak_upload":      rate("Velocidad pico de subida"),
+			"burst_download":   size("Ráfaga de descarga"),
+			"burst_upload":     size("Ráfaga de subida"),
+		},
+	}
+}
+
+// bandwidthUnitRegexp separa un valor de ancho de banda en cantidad y unidad
+var bandwidthUnitRegexp = regexp.MustCompile(`^\s*([0-9]+(?:\.[0-9]+)?)\s*([A-Za-z]*)(?:/s)?\s*$`)
+
+// bandwidthUnitBytes son los bytes que representa cada unidad. Se distingue
+// entre mayúsculas y minúsculas: "B" son bytes y "bit" bits. Las unidades en
+// bits usan prefijos decimales (como tc) y las de bytes binarios (como libvirt).
+var bandwidthUnitBytes = map[string]float64{
+	"":     1024,
+	"B":    1,
+	"KB":   1024,
+	"kB":   1024,
+	"KiB":  1024,
+	"MB":   1024 * 1024,
+	"MiB":  1024 * 1024,
+	"GB":   1024 * 1024 * 1024,
+	"GiB":  1024 * 1024 * 1024,
+	"bit":  1.0 / 8,
+	"kbit": 1e3 / 8,
+	"Kbit": 1e3 / 8,
+	"mbit": 1e6 / 8,
+	"Mbit": 1e6 / 8,
+	"gbit": 1e9 / 8,
+	"Gbit": 1e9 / 8,
+}
+
+// bandwidthAmbiguousUnits son unidades que unos entienden como bits y otros
+// como bytes ("100Mb"), por lo que se rechazan en lugar de adivinar
+var bandwidthAmbiguousUnits = map[string]bool{
+	"b": true, "kb": true, "Kb": true, "mb": true, "Mb": true, "gb": true, "Gb": true,
+}
+
+// parseBandwidth convierte un valor con unidades ("100Mbit", "10MB", "512") a KB (KB/s para velocidades)
+func parseBandwidth(value string) (int64, error) {
+	match := bandwidthUnitRegexp.FindStringSubmatch(value)
+	if match == nil {
+		return 0, fmt.Errorf("%q no es un valor válido; usa un número seguido de una unidad (B, KB, MB, GB, bit, Kbit, Mbit, Gbit)", value)
+	}
+
+	amount, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%q no es un número válido", match[1])
+	}
+
+	unit := match[2]
+	if bandwidthAmbiguousUnits[unit] {
+		return 0, fmt.Errorf("unidad %q ambigua en %q; usa %sB para bytes o %sbit para bits",
+			unit, value, strings.ToUpper(unit[:len(unit)-1]), strings.ToUpper(unit[:len(unit)-1]))
+	}
+	factor, ok := bandwidthUnitBytes[unit]
+	if !ok {
+		return 0, fmt.Errorf("unidad %q no soportada en %q; usa B, KB, MB, GB, bit, Kbit, Mbit o Gbit", unit, value)
+	}
+
+	result := int64(math.Round(amount * factor / 1024))
+	if result == 0 && amount > 0 {
+		return 0, fmt.Errorf("%q es menor que 1 KB, el valor mínimo que admite libvirt", value)
+	}
+
+	return result, nil
+}
+
+// bandwidthFromAPI extrae un valor numérico del mapa bandwidth de la API (null si no existe).
+// Acepta números JSON, enteros y cadenas (con o sin unidades).
+func bandwidthFromAPI(bandwidth map[string]interface{}, key string) types.Int64 {
+	switch val := bandwidth[key].(type) {
+	case float64:
+		return types.Int64Value(int64(val))
+	case int:
+		return types.Int64Value(int64(val))
+	case int64:
+		return types.Int64Value(val)
+	case string:
+		if parsed, err := parseBandwidth(val); err == nil {
+			return types.Int64Value(parsed)
+		}
+	}
+	return types.Int64Null()
+}
+
+// validateBandwidth comprueba la coherencia de los valores de una dirección (download o upload):
+// el pico no puede ser menor que la media y, si hay pico, la ráfaga debe ser mayor que 0.
+// Devuelve el campo afectado junto con el error.
+func validateBandwidth(values map[string]types.Int64, direction string) (string, error) {
+	average := values["average_"+direction]
+	peak := values["peak_"+direction]
+	burst := values["burst_"+direction]
+
+	if peak.IsNull() || peak.IsUnknown() {
+		return "", nil
+	}
+
+	if !average.IsNull() && !average.IsUnknown() && peak.ValueInt64() < average.ValueInt64() {
+		return "peak_" + direction, fmt.Errorf("peak_%s (%d KB/s) no puede ser menor que average_%s (%d KB/s)",
+			direction, peak.ValueInt64(), direction, average.ValueInt64())
+	}
+
+	if burst.IsUnknown() {
+		return "", nil
+	}
+	if burst.IsNull() || burst.ValueInt64() <= 0 {
+		return "burst_" + direction, fmt.Errorf("burst_%s debe ser mayor que 0 cuando se indica peak_%s", direction, direction)
+	}
+
+	return "", nil
+}
+
+// bandwidthToAPI construye el objeto bandwidth de la API con los valores conocidos
+func bandwidthToAPI(values map[string]types.Int64) map[string]interface{} {
+	bandwidth := make(map[string]interface{})
+	for _, field := range qosBandwidthFields {
+		if value := values[field]; !value.IsNull() && !value.IsUnknown() {
+			bandwidth[field] = value.ValueInt64()
+		}
+	}
+	return bandwidth
+}
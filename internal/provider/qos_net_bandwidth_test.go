@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseBandwidth(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int64
+		wantErr bool
+	}{
+		{value: "512", want: 512},
+		{value: "100Mbit", want: 12207},
+		{value: "100Mbit/s", want: 12207},
+		{value: "100mbit", want: 12207},
+		{value: "1Gbit", want: 122070},
+		{value: "8000bit", want: 1},
+		{value: "10MB", want: 10240},
+		{value: "10MiB", want: 10240},
+		{value: "1.5 KB", want: 2},
+		{value: "1GB", want: 1048576},
+		{value: "512B", want: 1},
+		{value: "0", want: 0},
+		{value: "100B", wantErr: true},
+		{value: "100Mb", wantErr: true},
+		{value: "100mb", wantErr: true},
+		{value: "100Kb", wantErr: true},
+		{value: "1Gb", wantErr: true},
+		{value: "100b", wantErr: true},
+		{value: "100MBIT", wantErr: true},
+		{value: "100TB", wantErr: true},
+		{value: "rápido", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseBandwidth(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseBandwidth(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseBandwidth(%q) = %d, want %d", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBandwidth(t *testing.T) {
+	null := types.Int64Null()
+	value := types.Int64Value
+
+	tests := []struct {
+		name                 string
+		average, peak, burst types.Int64
+		wantField            string
+	}{
+		{name: "sin pico", average: value(100), peak: null, burst: null},
+		{name: "pico y ráfaga", average: value(100), peak: value(200), burst: value(1024)},
+		{name: "pico igual a la media", average: value(100), peak: value(100), burst: value(1)},
+		{name: "pico sin media", average: null, peak: value(200), burst: value(1024)},
+		{name: "pico menor que la media", average: value(200), peak: value(100), burst: value(1024), wantField: "peak_download"},
+		{name: "pico sin ráfaga", average: value(100), peak: value(200), burst: null, wantField: "burst_download"},
+		{name: "ráfaga 0", average: value(100), peak: value(200), burst: value(0), wantField: "burst_download"},
+		{name: "ráfaga desconocida", average: value(100), peak: value(200), burst: types.Int64Unknown()},
+		{name: "pico desconocido", average: value(100), peak: types.Int64Unknown(), burst: null},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]types.Int64{
+				"average_download": tt.average,
+				"peak_download":    tt.peak,
+				"burst_download":   tt.burst,
+			}
+			field, err := validateBandwidth(values, "download")
+			if field != tt.wantField {
+				t.Errorf("validateBandwidth field = %q, want %q", field, tt.wantField)
+			}
+			if (err != nil) != (tt.wantField != "") {
+				t.Errorf("validateBandwidth error = %v, want error %v", err, tt.wantField != "")
+			}
+		})
+	}
+}
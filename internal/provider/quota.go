@@ -0,0 +1,129 @@
+package provider
+
+import (
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// quotaModel representa un objeto de cuota o de límites de Isard.
+//
+// La API usa false para indicar que no hay valores propios (se heredan del
+// grupo o de la categoría) y un objeto con los valores en caso contrario.
+// En Terraform un bloque omitido (nil) equivale a false.
+type quotaModel struct {
+	Desktops          types.Int64 `tfsdk:"desktops"`
+	Volatile          types.Int64 `tfsdk:"volatile"`
+	Running           types.Int64 `tfsdk:"running"`
+	VCPUs             types.Int64 `tfsdk:"vcpus"`
+	Memory            types.Int64 `tfsdk:"memory"`
+	Templates         types.Int64 `tfsdk:"templates"`
+	Isos              types.Int64 `tfsdk:"isos"`
+	DesktopsDiskSize  types.Int64 `tfsdk:"desktops_disk_size"`
+	TemplatesDiskSize types.Int64 `tfsdk:"templates_disk_size"`
+	IsosDiskSize      types.Int64 `tfsdk:"isos_disk_size"`
+	TotalSize         types.Int64 `tfsdk:"total_size"`
+}
+
+// quotaFields relaciona cada clave de la API con su campo del modelo
+func (m *quotaModel) quotaFields() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"desktops":            &m.Desktops,
+		"volatile":            &m.Volatile,
+		"running":             &m.Running,
+		"vcpus":               &m.VCPUs,
+		"memory":              &m.Memory,
+		"templates":           &m.Templates,
+		"isos":                &m.Isos,
+		"desktops_disk_size":  &m.DesktopsDiskSize,
+		"templates_disk_size": &m.TemplatesDiskSize,
+		"isos_disk_size":      &m.IsosDiskSize,
+		"total_size":          &m.TotalSize,
+	}
+}
+
+// quotaAttributeSchema devuelve el esquema del atributo anidado de cuota/límites
+func quotaAttributeSchema(description string) schema.SingleNestedAttribute {
+	attribute := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: description,
+		}
+	}
+
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"desktops":            attribute("Número máximo de desktops persistentes"),
+			"volatile":            attribute("Número máximo de desktops volátiles"),
+			"running":             attribute("Número máximo de desktops arrancados a la vez"),
+			"vcpus":               attribute("Número máximo de vCPUs por desktop"),
+			"memory":              attribute("Memoria máxima por desktop en GB"),
+			"templates":           attribute("Número máximo de templates"),
+			"isos":                attribute("Número máximo de medios (ISOs y floppies)"),
+			"desktops_disk_size":  attribute("Tamaño máximo de disco por desktop en GB"),
+			"templates_disk_size": attribute("Tamaño máximo de disco por template en GB"),
+			"isos_disk_size":      attribute("Tamaño máximo por medio en GB"),
+			"total_size":          attribute("Tamaño total máximo de almacenamiento en GB"),
+		},
+	}
+}
+
+// quotaDataSourceAttributeSchema devuelve el esquema computado de cuota/límites/uso para data sources
+func quotaDataSourceAttributeSchema(description string) dsschema.SingleNestedAttribute {
+	attributes := make(map[string]dsschema.Attribute)
+	for key := range (&quotaModel{}).quotaFields() {
+		attributes[key] = dsschema.Int64Attribute{
+			Computed: true,
+		}
+	}
+
+	return dsschema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description,
+		Attributes:  attributes,
+	}
+}
+
+// quotaToAPI convierte el modelo al valor que espera la API (false si es nil)
+func quotaToAPI(m *quotaModel) interface{} {
+	if m == nil {
+		return false
+	}
+
+	quota := make(map[string]interface{})
+	for key, field := range m.quotaFields() {
+		if !field.IsNull() && !field.IsUnknown() {
+			quota[key] = field.ValueInt64()
+		}
+	}
+	return quota
+}
+
+// setQuotaUpdate añade key a updateData solo si la cuota se gestiona en el plan
+// o se gestionaba en el estado (quitarla envía false). Así no se sobrescribe la
+// cuota gestionada con isard_quota.
+func setQuotaUpdate(updateData map[string]interface{}, key string, plan, state *quotaModel) {
+	if plan != nil || state != nil {
+		updateData[key] = quotaToAPI(plan)
+	}
+}
+
+// quotaFromAPI convierte el valor de la API al modelo de Terraform (nil si es false)
+func quotaFromAPI(value interface{}) *quotaModel {
+	raw, ok := value.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	m := &quotaModel{}
+	for key, field := range m.quotaFields() {
+		*field = types.Int64Null()
+		if val, ok := raw[key].(float64); ok {
+			*field = types.Int64Value(int64(val))
+		}
+	}
+	return m
+}
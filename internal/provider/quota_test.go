@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// nullQuota devuelve un quotaModel con todos los campos a null
+func nullQuota() *quotaModel {
+	m := &quotaModel{}
+	for _, field := range m.quotaFields() {
+		*field = types.Int64Null()
+	}
+	return m
+}
+
+func TestQuotaToAPI(t *testing.T) {
+	partial := nullQuota()
+	partial.Desktops = types.Int64Value(2)
+	partial.Running = types.Int64Value(1)
+	partial.Memory = types.Int64Unknown()
+
+	tests := []struct {
+		name  string
+		model *quotaModel
+		want  interface{}
+	}{
+		{"nil", nil, false},
+		{"sin valores", nullQuota(), map[string]interface{}{}},
+		{"valores conocidos", partial, map[string]interface{}{"desktops": int64(2), "running": int64(1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quotaToAPI(tt.model); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("quotaToAPI = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuotaFromAPI(t *testing.T) {
+	partial := nullQuota()
+	partial.Desktops = types.Int64Value(2)
+	partial.TotalSize = types.Int64Value(500)
+
+	tests := []struct {
+		name  string
+		value interface{}
+		want  *quotaModel
+	}{
+		{"false", false, nil},
+		{"null", nil, nil},
+		{"objeto", map[string]interface{}{"desktops": float64(2), "total_size": float64(500), "unknown": float64(1)}, partial},
+		{"objeto vacío", map[string]interface{}{}, nullQuota()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quotaFromAPI(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("quotaFromAPI = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetQuotaUpdate(t *testing.T) {
+	quota := nullQuota()
+	quota.Desktops = types.Int64Value(3)
+
+	tests := []struct {
+		name        string
+		plan, state *quotaModel
+		want        map[string]interface{}
+	}{
+		{"no gestionada", nil, nil, map[string]interface{}{}},
+		{"gestionada", quota, nil, map[string]interface{}{"quota": map[string]interface{}{"desktops": int64(3)}}},
+		{"se deja de gestionar", nil, quota, map[string]interface{}{"quota": false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updateData := map[string]interface{}{}
+			setQuotaUpdate(updateData, "quota", tt.plan, tt.state)
+			if !reflect.DeepEqual(updateData, tt.want) {
+				t.Errorf("setQuotaUpdate = %#v, want %#v", updateData, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// reservablesModel representa los recursos reservables (perfil de vGPU) de un desktop.
+//
+// La API espera {"vgpus": ["<perfil>"]} o {"vgpus": ["None"]} si no se reserva
+// ninguna GPU. En Terraform un atributo omitido (nil) deja el valor del template.
+type reservablesModel struct {
+	VGPU types.String `tfsdk:"vgpu"`
+}
+
+// reservablesAttributeSchema devuelve el esquema del atributo anidado de reservables
+func reservablesAttributeSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"vgpu": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID del perfil de vGPU (ver `isard_gpu_profiles`)",
+			},
+		},
+	}
+}
+
+// toAPI convierte el modelo al formato de la API
+func (m *reservablesModel) toAPI() *client.Reservables {
+	if m == nil || m.VGPU.IsUnknown() {
+		return nil
+	}
+	return &client.Reservables{VGPUs: []string{m.VGPU.ValueString()}}
+}
+
+// reservablesFromAPI convierte los reservables de la API (nil si no se reserva ninguna GPU)
+func reservablesFromAPI(reservables *client.Reservables) *reservablesModel {
+	vgpu := reservables.VGPU()
+	if vgpu == "" {
+		return nil
+	}
+	return &reservablesModel{VGPU: types.StringValue(vgpu)}
+}
+
+// reservablesUpdate devuelve los reservables a enviar en un update: los del plan,
+// ninguno si se han dejado de gestionar, o nil si no hay nada que cambiar
+func reservablesUpdate(plan, state *reservablesModel) *client.Reservables {
+	if plan != nil && state != nil && plan.VGPU.Equal(state.VGPU) {
+		return nil
+	}
+	if plan != nil {
+		return plan.toAPI()
+	}
+	if state != nil {
+		return client.NoReservables()
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+func TestReservablesUpdate(t *testing.T) {
+	a40 := &reservablesModel{VGPU: types.StringValue("NVIDIA-A40-2Q")}
+	a16 := &reservablesModel{VGPU: types.StringValue("NVIDIA-A16-1B")}
+
+	tests := []struct {
+		name        string
+		plan, state *reservablesModel
+		want        *client.Reservables
+	}{
+		{name: "no gestionado", plan: nil, state: nil, want: nil},
+		{name: "sin cambios", plan: a40, state: a40, want: nil},
+		{name: "nuevo perfil", plan: a40, state: nil, want: &client.Reservables{VGPUs: []string{"NVIDIA-A40-2Q"}}},
+		{name: "cambio de perfil", plan: a16, state: a40, want: &client.Reservables{VGPUs: []string{"NVIDIA-A16-1B"}}},
+		{name: "se deja de gestionar", plan: nil, state: a40, want: client.NoReservables()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := reservablesUpdate(tt.plan, tt.state); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("reservablesUpdate = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
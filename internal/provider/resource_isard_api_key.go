@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &apiKeyResource{}
+	_ resource.ResourceWithConfigure   = &apiKeyResource{}
+	_ resource.ResourceWithImportState = &apiKeyResource{}
+)
+
+// NewAPIKeyResource is a helper function to simplify the provider implementation.
+func NewAPIKeyResource() resource.Resource {
+	return &apiKeyResource{}
+}
+
+// apiKeyResource is the resource implementation.
+type apiKeyResource struct {
+	client *client.Client
+}
+
+// apiKeyResourceModel maps the resource schema data.
+type apiKeyResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	UserID        types.String `tfsdk:"user_id"`
+	Name          types.String `tfsdk:"name"`
+	ExpiresInDays types.Int64  `tfsdk:"expires_in_days"`
+	RotateTrigger types.String `tfsdk:"rotate_trigger"`
+	Token         types.String `tfsdk:"token"`
+	CreatedAt     types.String `tfsdk:"created_at"`
+	ExpiresAt     types.String `tfsdk:"expires_at"`
+}
+
+// setKey guarda en el modelo los valores de un API key recién creado
+func (m *apiKeyResourceModel) setKey(key *client.APIKey) {
+	m.ID = types.StringValue(key.ID)
+	m.Token = types.StringValue(key.Token)
+	m.CreatedAt = types.StringValue(key.CreatedAt)
+	m.ExpiresAt = types.StringNull()
+	if key.ExpiresAt != "" {
+		m.ExpiresAt = types.StringValue(key.ExpiresAt)
+	}
+}
+
+// Metadata returns the resource type name.
+func (r *apiKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *apiKeyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un API key (token de larga duración) de un usuario o cuenta de servicio de Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID del API key.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					rotateTriggerPlanModifier{},
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Description: "ID del usuario propietario del API key.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Nombre del API key, para identificarlo al revisar los tokens del usuario.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expires_in_days": schema.Int64Attribute{
+				Description: "Días de validez del API key desde su creación. Si se omite, no caduca.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_trigger": schema.StringAttribute{
+				Description: "Valor arbitrario. Al cambiarlo se crea un API key nuevo y se revoca el anterior.",
+				Optional:    true,
+			},
+			"token": schema.StringAttribute{
+				Description: "Secreto del API key. Isard solo lo devuelve al crearlo, por lo que se guarda en texto plano en el estado de Terraform (sensitive solo lo oculta de la salida). Para no guardar credenciales en el estado, usa el ephemeral resource isard_auth_token.",
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.String{
+					rotateTriggerPlanModifier{},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: "Fecha de creación en formato RFC 3339.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					rotateTriggerPlanModifier{},
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				Description: "Fecha de caducidad en formato RFC 3339 (null si no caduca).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					rotateTriggerPlanModifier{},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *apiKeyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *apiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan apiKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.CreateAPIKey(plan.UserID.ValueString(), plan.Name.ValueString(), plan.ExpiresInDays.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando API key",
+			"No se pudo crear el API key del usuario "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setKey(key)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *apiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := r.client.GetAPIKey(state.UserID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "api_key not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo API key",
+			"No se pudo leer el API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// El token no se vuelve a leer: se conserva el del estado
+	state.Name = types.StringValue(key.Name)
+	if key.CreatedAt != "" {
+		state.CreatedAt = types.StringValue(key.CreatedAt)
+	}
+	state.ExpiresAt = types.StringNull()
+	if key.ExpiresAt != "" {
+		state.ExpiresAt = types.StringValue(key.ExpiresAt)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update rota el API key: crea uno nuevo y revoca el anterior.
+// El resto de atributos fuerzan la recreación, así que solo cambia rotate_trigger.
+func (r *apiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state apiKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// rotate_trigger sin cambios (por ejemplo, null a null): nada que rotar
+	if plan.RotateTrigger.Equal(state.RotateTrigger) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		return
+	}
+
+	key, err := r.client.CreateAPIKey(plan.UserID.ValueString(), plan.Name.ValueString(), plan.ExpiresInDays.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rotando API key",
+			"No se pudo crear el nuevo API key del usuario "+plan.UserID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.setKey(key)
+
+	// Guardar el nuevo API key antes de revocar el anterior para no perderlo si falla la revocación
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.RevokeAPIKey(state.UserID.ValueString(), state.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddWarning(
+			"No se pudo revocar el API key anterior",
+			"El API key "+state.ID.ValueString()+" sigue siendo válido; revócalo manualmente: "+err.Error(),
+		)
+	}
+}
+
+// Delete revoca el API key y lo elimina del estado.
+func (r *apiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state apiKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.RevokeAPIKey(state.UserID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error revocando API key",
+			"No se pudo revocar el API key ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState importa un API key con el formato <user_id>/<id>. El token no se puede importar.
+func (r *apiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userID, keyID, ok := strings.Cut(req.ID, "/")
+	if !ok || userID == "" || keyID == "" {
+		resp.Diagnostics.AddError(
+			"ID de importación no válido",
+			"El ID debe tener el formato <user_id>/<id>, se recibió: "+req.ID,
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyID)...)
+}
@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &bookingResource{}
+	_ resource.ResourceWithConfigure      = &bookingResource{}
+	_ resource.ResourceWithValidateConfig = &bookingResource{}
+)
+
+// bookingItemTypes son los tipos de elemento cuyos recursos se pueden reservar
+var bookingItemTypes = []string{"desktop", "deployment"}
+
+// NewBookingResource is a helper function to simplify the provider implementation.
+func NewBookingResource() resource.Resource {
+	return &bookingResource{}
+}
+
+// bookingResource is the resource implementation.
+type bookingResource struct {
+	client *client.Client
+}
+
+// bookingResourceModel maps the resource schema data.
+type bookingResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	ItemType types.String `tfsdk:"item_type"`
+	ItemID   types.String `tfsdk:"item_id"`
+	Title    types.String `tfsdk:"title"`
+	Start    types.String `tfsdk:"start"`
+	End      types.String `tfsdk:"end"`
+}
+
+// Metadata returns the resource type name.
+func (r *bookingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_booking"
+}
+
+// Schema defines the schema for the resource.
+func (r *bookingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reserva en Isard VDI los recursos (perfil de vGPU) de un desktop o deployment durante una franja horaria.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador único de la reserva",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"item_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Tipo de elemento reservado (desktop, deployment)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(bookingItemTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID del desktop o deployment. Debe tener un perfil de vGPU en `reservables`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Título de la reserva en el calendario",
+			},
+			"start": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Inicio de la reserva en formato RFC 3339 (p. ej. `2026-03-02T09:00:00+01:00`)",
+			},
+			"end": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Fin de la reserva en formato RFC 3339",
+			},
+		},
+	}
+}
+
+// ValidateConfig comprueba el formato de las fechas y que el fin sea posterior al inicio.
+func (r *bookingResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config bookingResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	times := make(map[string]time.Time)
+	for name, value := range map[string]types.String{"start": config.Start, "end": config.End} {
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Fecha no válida",
+				fmt.Sprintf("%q no tiene formato RFC 3339: %s", value.ValueString(), err.Error()),
+			)
+			continue
+		}
+		times[name] = t
+	}
+
+	start, hasStart := times["start"]
+	end, hasEnd := times["end"]
+	if hasStart && hasEnd && !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("end"),
+			"Franja horaria no válida",
+			"El fin de la reserva debe ser posterior al inicio.",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *bookingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *bookingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bookingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bookingID, err := r.client.CreateBooking(&client.Booking{
+		Title:       plan.Title.ValueString(),
+		ElementID:   plan.ItemID.ValueString(),
+		ElementType: plan.ItemType.ValueString(),
+		Start:       plan.Start.ValueString(),
+		End:         plan.End.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando reserva",
+			"No se pudo reservar "+plan.ItemType.ValueString()+" "+plan.ItemID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(bookingID)
+
+	// Leer la reserva creada para obtener los valores computados
+	booking, err := r.client.GetBooking(bookingID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo reserva creada",
+			"No se pudo leer la reserva recién creada: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Title = types.StringValue(booking.Title)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *bookingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state bookingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	booking, err := r.client.GetBooking(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "booking not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo reserva",
+			"No se pudo leer la reserva ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Title = types.StringValue(booking.Title)
+	if booking.ElementID != "" {
+		state.ItemID = types.StringValue(booking.ElementID)
+	}
+	if booking.ElementType != "" {
+		state.ItemType = types.StringValue(booking.ElementType)
+	}
+	state.Start = refreshBookingTime(state.Start, booking.Start)
+	state.End = refreshBookingTime(state.End, booking.End)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *bookingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan bookingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateData := map[string]interface{}{
+		"start": plan.Start.ValueString(),
+		"end":   plan.End.ValueString(),
+	}
+	if !plan.Title.IsUnknown() {
+		updateData["title"] = plan.Title.ValueString()
+	}
+
+	err := r.client.UpdateBooking(plan.ID.ValueString(), updateData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando reserva",
+			"No se pudo actualizar la reserva ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Leer la reserva actualizada para obtener los valores computados
+	booking, err := r.client.GetBooking(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo reserva actualizada",
+			"No se pudo leer la reserva actualizada: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Title = types.StringValue(booking.Title)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *bookingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state bookingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBooking(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando reserva",
+			"No se pudo eliminar la reserva ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// refreshBookingTime devuelve la fecha leída de la API, conservando la del estado
+// si representa el mismo instante (la API puede devolverla en otra zona horaria)
+func refreshBookingTime(current types.String, value string) types.String {
+	if value == "" {
+		return current
+	}
+
+	apiTime, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return current
+	}
+	if stateTime, err := time.Parse(time.RFC3339, current.ValueString()); err == nil && stateTime.Equal(apiTime) {
+		return current
+	}
+
+	return types.StringValue(value)
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &categoryResource{}
+	_ resource.ResourceWithConfigure = &categoryResource{}
+)
+
+// NewCategoryResource is a helper function to simplify the provider implementation.
+func NewCategoryResource() resource.Resource {
+	return &categoryResource{}
+}
+
+// categoryResource is the resource implementation.
+type categoryResource struct {
+	client *client.Client
+}
+
+// categoryResourceModel maps the resource schema data.
+type categoryResourceModel struct {
+	ID            types.String    `tfsdk:"id"`
+	Name          types.String    `tfsdk:"name"`
+	Description   types.String    `tfsdk:"description"`
+	Frontend      types.Bool      `tfsdk:"frontend"`
+	CustomURLName types.String    `tfsdk:"custom_url_name"`
+	Ephemeral     *ephemeralModel `tfsdk:"ephemeral"`
+	Quota         *quotaModel     `tfsdk:"quota"`
+	Limits        *quotaModel     `tfsdk:"limits"`
+}
+
+// ephemeralModel representa la configuración de desktops efímeros de la categoría
+type ephemeralModel struct {
+	Minutes types.Int64  `tfsdk:"minutes"`
+	Action  types.String `tfsdk:"action"`
+}
+
+// ephemeralActions son las acciones que Isard aplica al expirar un desktop efímero
+var ephemeralActions = []string{"Stopping", "StoppingAndDeleting"}
+
+// Metadata returns the resource type name.
+func (r *categoryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+// Schema defines the schema for the resource.
+func (r *categoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona una categoría en Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador único de la categoría",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Nombre de la categoría",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Descripción de la categoría",
+			},
+			"frontend": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Si la categoría aparece en el desplegable de la página de login (por defecto: false)",
+			},
+			"custom_url_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Nombre usado en la URL de login propia de la categoría (`/login/<custom_url_name>`)",
+			},
+			"ephemeral": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Desktops efímeros: tiempo máximo que un desktop puede estar arrancado antes de aplicar `action`",
+				Attributes: map[string]schema.Attribute{
+					"minutes": schema.Int64Attribute{
+						Required:            true,
+						MarkdownDescription: "Minutos que puede estar arrancado un desktop",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"action": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: "Acción al expirar (Stopping, StoppingAndDeleting)",
+						Validators: []validator.String{
+							stringvalidator.OneOf(ephemeralActions...),
+						},
+					},
+				},
+			},
+			"quota":  quotaAttributeSchema("Cuota por usuario de la categoría. Si se omite, no se aplica cuota."),
+			"limits": quotaAttributeSchema("Límites totales de la categoría. Si se omiten, no se aplican límites."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *categoryResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *categoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan categoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category := &client.Category{
+		Name:          plan.Name.ValueString(),
+		Description:   plan.Description.ValueString(),
+		Frontend:      plan.Frontend.ValueBool(),
+		CustomURLName: plan.CustomURLName.ValueString(),
+		Quota:         quotaToAPI(plan.Quota),
+		Limits:        quotaToAPI(plan.Limits),
+	}
+	if plan.Ephemeral != nil {
+		category.Ephemeral = map[string]interface{}{
+			"minutes": plan.Ephemeral.Minutes.ValueInt64(),
+			"action":  plan.Ephemeral.Action.ValueString(),
+		}
+	}
+
+	categoryID, err := r.client.CreateCategory(category)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando categoría",
+			"No se pudo crear la categoría: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(categoryID)
+
+	// Leer la categoría creada para obtener los valores computados
+	created, err := r.client.GetCategory(categoryID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo categoría creada",
+			"No se pudo leer la categoría recién creada: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *categoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state categoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	category, err := r.client.GetCategory(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "category not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo categoría",
+			"No se pudo leer la categoría ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(category.Name)
+	state.Frontend = types.BoolValue(category.Frontend)
+	state.Ephemeral = ephemeralFromAPI(category.Ephemeral)
+
+	r.mapComputed(category, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *categoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan categoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state categoryResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateData := map[string]interface{}{
+		"name":      plan.Name.ValueString(),
+		"frontend":  plan.Frontend.ValueBool(),
+		"ephimeral": false,
+	}
+	setQuotaUpdate(updateData, "quota", plan.Quota, state.Quota)
+	setQuotaUpdate(updateData, "limits", plan.Limits, state.Limits)
+	if !plan.Description.IsUnknown() {
+		updateData["description"] = plan.Description.ValueString()
+	}
+	if !plan.CustomURLName.IsUnknown() {
+		updateData["custom_url_name"] = plan.CustomURLName.ValueString()
+	}
+	if plan.Ephemeral != nil {
+		updateData["ephimeral"] = map[string]interface{}{
+			"minutes": plan.Ephemeral.Minutes.ValueInt64(),
+			"action":  plan.Ephemeral.Action.ValueString(),
+		}
+	}
+
+	err := r.client.UpdateCategory(plan.ID.ValueString(), updateData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando categoría",
+			"No se pudo actualizar la categoría ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Leer la categoría actualizada para obtener los valores computados
+	updated, err := r.client.GetCategory(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo categoría actualizada",
+			"No se pudo leer la categoría actualizada: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *categoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state categoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCategory(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando categoría",
+			"No se pudo eliminar la categoría ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// mapComputed copia al modelo los valores computados (descripción, URL,
+// cuota y límites) devueltos por la API
+func (r *categoryResource) mapComputed(category *client.Category, model *categoryResourceModel) {
+	model.Description = types.StringValue(category.Description)
+	model.CustomURLName = types.StringValue(category.CustomURLName)
+
+	// Cuota y límites solo se reflejan si se gestionan desde Terraform
+	if model.Quota != nil {
+		model.Quota = quotaFromAPI(category.Quota)
+	}
+	if model.Limits != nil {
+		model.Limits = quotaFromAPI(category.Limits)
+	}
+}
+
+// ephemeralFromAPI convierte la configuración "ephimeral" de la API (nil si está deshabilitada)
+func ephemeralFromAPI(value interface{}) *ephemeralModel {
+	ephemeral, _ := value.(map[string]interface{})
+	minutes, ok := ephemeral["minutes"].(float64)
+	if !ok {
+		return nil
+	}
+
+	action, _ := ephemeral["action"].(string)
+	return &ephemeralModel{
+		Minutes: types.Int64Value(int64(minutes)),
+		Action:  types.StringValue(action),
+	}
+}
@@ -2,10 +2,10 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -36,18 +36,23 @@ type deploymentResource struct {
 
 // deploymentResourceModel maps the resource schema data.
 type deploymentResourceModel struct {
-	ID              types.String `tfsdk:"id"`
-	Name            types.String `tfsdk:"name"`
-	Description     types.String `tfsdk:"description"`
-	TemplateID      types.String `tfsdk:"template_id"`
-	DesktopName     types.String `tfsdk:"desktop_name"`
-	Visible         types.Bool   `tfsdk:"visible"`
-	Allowed         types.Object `tfsdk:"allowed"`
-	VCPUs           types.Int64  `tfsdk:"vcpus"`
-	Memory          types.Float64 `tfsdk:"memory"`
-	Interfaces      types.List   `tfsdk:"interfaces"`
-	UserPermissions types.List   `tfsdk:"user_permissions"`
-	Viewers         types.List   `tfsdk:"viewers"`
+	ID              types.String      `tfsdk:"id"`
+	Name            types.String      `tfsdk:"name"`
+	Description     types.String      `tfsdk:"description"`
+	TemplateID      types.String      `tfsdk:"template_id"`
+	DesktopName     types.String      `tfsdk:"desktop_name"`
+	Visible         types.Bool        `tfsdk:"visible"`
+	Allowed         *allowedModel     `tfsdk:"allowed"`
+	VCPUs           types.Int64       `tfsdk:"vcpus"`
+	Memory          types.Float64     `tfsdk:"memory"`
+	Interfaces      types.List        `tfsdk:"interfaces"`
+	UserPermissions types.List        `tfsdk:"user_permissions"`
+	Viewers         types.List        `tfsdk:"viewers"`
+	Viewer          []viewerModel     `tfsdk:"viewer"`
+	Fullscreen      types.Bool        `tfsdk:"fullscreen"`
+	Credentials     *credentialsModel `tfsdk:"credentials"`
+	Reservables     *reservablesModel `tfsdk:"reservables"`
+	QoSDiskID       types.String      `tfsdk:"qos_disk_id"`
 }
 
 // Metadata returns the resource type name.
@@ -93,32 +98,7 @@ func (r *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Si los desktops del deployment son visibles para los usuarios (por defecto: false)",
 			},
-			"allowed": schema.SingleNestedAttribute{
-				Required:            true,
-				MarkdownDescription: "Configuración de usuarios, grupos y categorías permitidos para acceder a este deployment",
-				Attributes: map[string]schema.Attribute{
-					"roles": schema.ListAttribute{
-						ElementType:         types.StringType,
-						Optional:            true,
-						MarkdownDescription: "Lista de roles permitidos",
-					},
-					"categories": schema.ListAttribute{
-						ElementType:         types.StringType,
-						Optional:            true,
-						MarkdownDescription: "Lista de IDs de categorías permitidas",
-					},
-					"groups": schema.ListAttribute{
-						ElementType:         types.StringType,
-						Optional:            true,
-						MarkdownDescription: "Lista de IDs de grupos permitidos",
-					},
-					"users": schema.ListAttribute{
-						ElementType:         types.StringType,
-						Optional:            true,
-						MarkdownDescription: "Lista de IDs de usuarios permitidos",
-					},
-				},
-			},
+			"allowed": allowedAttributeSchema("Configuración de usuarios, grupos y categorías permitidos para acceder a este deployment", true),
 			"vcpus": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
@@ -150,7 +130,15 @@ func (r *deploymentResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				ElementType:         types.StringType,
 				Optional:            true,
 				MarkdownDescription: "Lista de viewers habilitados (ej: ['browser_vnc', 'file_spice', 'file_rdpgw', 'browser_rdp']). Si no se especifica, se usan los del template.",
+				DeprecationMessage:  "Usa los bloques `viewer`, que permiten configurar las opciones de cada viewer.",
 			},
+			"fullscreen":  fullscreenAttributeSchema(),
+			"credentials": credentialsAttributeSchema(),
+			"reservables": reservablesAttributeSchema("Perfil de vGPU reservable de los desktops. Si se omite, usa el del template"),
+			"qos_disk_id": qosDiskIDAttributeSchema("ID del perfil de QoS de disco (límites de E/S) de los desktops. Si se omite, usa el del template; quitarlo después de haberlo gestionado aplica `unlimited`"),
+		},
+		Blocks: map[string]schema.Block{
+			"viewer": viewerBlockSchema(),
 		},
 	}
 }
@@ -184,71 +172,11 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	// Extraer el objeto allowed
-	allowedAttrs := plan.Allowed.Attributes()
-
 	// Construir el mapa allowed para la API
-	// La API espera false para campos no utilizados, no omitirlos
-	allowed := make(map[string]interface{})
-	
-	if rolesAttr, ok := allowedAttrs["roles"]; ok {
-		if roles, ok := rolesAttr.(types.List); ok && !roles.IsNull() {
-			var rolesList []string
-			diags := roles.ElementsAs(ctx, &rolesList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				if len(rolesList) > 0 {
-					allowed["roles"] = rolesList
-				} else {
-					allowed["roles"] = false
-				}
-			}
-		}
-	}
-	
-	if categoriesAttr, ok := allowedAttrs["categories"]; ok {
-		if categories, ok := categoriesAttr.(types.List); ok && !categories.IsNull() {
-			var categoriesList []string
-			diags := categories.ElementsAs(ctx, &categoriesList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				if len(categoriesList) > 0 {
-					allowed["categories"] = categoriesList
-				} else {
-					allowed["categories"] = false
-				}
-			}
-		}
-	}
-	
-	if groupsAttr, ok := allowedAttrs["groups"]; ok {
-		if groups, ok := groupsAttr.(types.List); ok && !groups.IsNull() {
-			var groupsList []string
-			diags := groups.ElementsAs(ctx, &groupsList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				if len(groupsList) > 0 {
-					allowed["groups"] = groupsList
-				} else {
-					allowed["groups"] = false
-				}
-			}
-		}
-	}
-	
-	if usersAttr, ok := allowedAttrs["users"]; ok {
-		if users, ok := usersAttr.(types.List); ok && !users.IsNull() {
-			var usersList []string
-			diags := users.ElementsAs(ctx, &usersList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				if len(usersList) > 0 {
-					allowed["users"] = usersList
-				} else {
-					allowed["users"] = false
-				}
-			}
-		}
+	allowed, diags := plan.Allowed.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Preparar hardware personalizado si se especifica
@@ -292,18 +220,22 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 	}
 
-	// Construir guest_properties si se especifican viewers
-	var guestProperties map[string]interface{}
-	if len(viewers) > 0 {
-		viewersMap := make(map[string]interface{})
-		for _, viewer := range viewers {
-			viewersMap[viewer] = map[string]interface{}{"options": nil}
-		}
-		guestProperties = map[string]interface{}{
-			"viewers": viewersMap,
+	// Construir guest_properties (viewers, fullscreen y credenciales)
+	var password types.String
+	if plan.Credentials != nil {
+		diags = req.Config.GetAttribute(ctx, path.Root("credentials").AtName("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
+	guestProperties, diags := buildGuestProperties(ctx, plan.Viewer, viewers, plan.Fullscreen, plan.Credentials, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Crear el deployment usando la API
 	deploymentID, err := r.client.CreateDeployment(
 		plan.Name.ValueString(),
@@ -318,6 +250,8 @@ func (r *deploymentResource) Create(ctx context.Context, req resource.CreateRequ
 		guestProperties,
 		nil, // image
 		userPermissions,
+		plan.Reservables.toAPI(),
+		plan.QoSDiskID.ValueString(),
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -381,70 +315,38 @@ func (r *deploymentResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Actualizar allowed
 	if deployment.Allowed != nil {
-		allowedBytes, err := json.Marshal(deployment.Allowed)
-		if err == nil {
-			var allowedMap map[string]interface{}
-			if err := json.Unmarshal(allowedBytes, &allowedMap); err == nil {
-				// Construir el objeto allowed para terraform
-				allowedAttrs := make(map[string]attr.Value)
-				
-				if roles, ok := allowedMap["roles"].([]interface{}); ok {
-					rolesList := make([]attr.Value, len(roles))
-					for i, role := range roles {
-						rolesList[i] = types.StringValue(fmt.Sprintf("%v", role))
-					}
-					allowedAttrs["roles"] = types.ListValueMust(types.StringType, rolesList)
-				} else {
-					allowedAttrs["roles"] = types.ListNull(types.StringType)
-				}
-				
-				if categories, ok := allowedMap["categories"].([]interface{}); ok {
-					categoriesList := make([]attr.Value, len(categories))
-					for i, cat := range categories {
-						categoriesList[i] = types.StringValue(fmt.Sprintf("%v", cat))
-					}
-					allowedAttrs["categories"] = types.ListValueMust(types.StringType, categoriesList)
-				} else {
-					allowedAttrs["categories"] = types.ListNull(types.StringType)
-				}
-				
-				if groups, ok := allowedMap["groups"].([]interface{}); ok {
-					groupsList := make([]attr.Value, len(groups))
-					for i, group := range groups {
-						groupsList[i] = types.StringValue(fmt.Sprintf("%v", group))
-					}
-					allowedAttrs["groups"] = types.ListValueMust(types.StringType, groupsList)
-				} else {
-					allowedAttrs["groups"] = types.ListNull(types.StringType)
-				}
-				
-				if users, ok := allowedMap["users"].([]interface{}); ok {
-					usersList := make([]attr.Value, len(users))
-					for i, user := range users {
-						usersList[i] = types.StringValue(fmt.Sprintf("%v", user))
-					}
-					allowedAttrs["users"] = types.ListValueMust(types.StringType, usersList)
-				} else {
-					allowedAttrs["users"] = types.ListNull(types.StringType)
-				}
-				
-				allowedType := types.ObjectType{
-					AttrTypes: map[string]attr.Type{
-						"roles":      types.ListType{ElemType: types.StringType},
-						"categories": types.ListType{ElemType: types.StringType},
-						"groups":     types.ListType{ElemType: types.StringType},
-						"users":      types.ListType{ElemType: types.StringType},
-					},
-				}
-				state.Allowed = types.ObjectValueMust(allowedType.AttrTypes, allowedAttrs)
-			}
-		}
+		allowed, diags := allowedFromAPI(ctx, deployment.Allowed)
+		resp.Diagnostics.Append(diags...)
+		state.Allowed = allowed
 	}
 
 	// Nota: La API devuelve null para hardware en deployments
 	// Los valores de vcpus, memory e interfaces se mantienen del state
 	// ya que son los que se enviaron en la creación
 
+	// Refrescar guest_properties y qos_disk_id solo si están gestionados desde Terraform
+	if len(state.Viewer) > 0 || !state.Fullscreen.IsNull() || state.Credentials != nil || !state.QoSDiskID.IsNull() {
+		info, err := r.client.GetDeploymentInfo(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error leyendo el deployment",
+				fmt.Sprintf("No se pudo leer la información del deployment (ID: %s): %s", state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		if guestProps, ok := info["guest_properties"].(map[string]interface{}); ok {
+			resp.Diagnostics.Append(refreshGuestProperties(ctx, guestProps, &state.Viewer, &state.Fullscreen, state.Credentials)...)
+		}
+		if hardware, ok := info["hardware"].(map[string]interface{}); ok && !state.QoSDiskID.IsNull() {
+			// qos_disk_id es false si los desktops no tienen perfil de QoS de disco
+			qosDiskID, ok := hardware["qos_disk_id"].(string)
+			if !ok || qosDiskID == "" {
+				qosDiskID = client.QoSDiskUnlimited
+			}
+			state.QoSDiskID = types.StringValue(qosDiskID)
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -458,80 +360,79 @@ func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	var state deploymentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Construir los datos de actualización
 	updateData := make(map[string]interface{})
 	updateData["name"] = plan.Name.ValueString()
-	updateData["description"] = plan.Description.ValueString()
+	if !plan.Description.IsUnknown() {
+		updateData["description"] = plan.Description.ValueString()
+	}
 	updateData["desktop_name"] = plan.DesktopName.ValueString()
 
-	// Extraer el objeto allowed
-	allowedAttrs := plan.Allowed.Attributes()
-
-	// Construir el mapa allowed para la API
-	allowed := make(map[string]interface{})
-	
-	if rolesAttr, ok := allowedAttrs["roles"]; ok {
-		if roles, ok := rolesAttr.(types.List); ok && !roles.IsNull() {
-			var rolesList []string
-			diags := roles.ElementsAs(ctx, &rolesList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() && len(rolesList) > 0 {
-				allowed["roles"] = rolesList
-			}
-		}
-	}
-	
-	if categoriesAttr, ok := allowedAttrs["categories"]; ok {
-		if categories, ok := categoriesAttr.(types.List); ok && !categories.IsNull() {
-			var categoriesList []string
-			diags := categories.ElementsAs(ctx, &categoriesList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() && len(categoriesList) > 0 {
-				allowed["categories"] = categoriesList
-			}
-		}
-	}
-	
-	if groupsAttr, ok := allowedAttrs["groups"]; ok {
-		if groups, ok := groupsAttr.(types.List); ok && !groups.IsNull() {
-			var groupsList []string
-			diags := groups.ElementsAs(ctx, &groupsList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() && len(groupsList) > 0 {
-				allowed["groups"] = groupsList
-			}
-		}
-	}
-	
-	if usersAttr, ok := allowedAttrs["users"]; ok {
-		if users, ok := usersAttr.(types.List); ok && !users.IsNull() {
-			var usersList []string
-			diags := users.ElementsAs(ctx, &usersList, false)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() && len(usersList) > 0 {
-				allowed["users"] = usersList
-			}
-		}
+	// Construir el mapa allowed para la API. Se envían siempre las cuatro
+	// claves para que quitar una lista deje de compartir el deployment.
+	allowed, diags := plan.Allowed.toAPI(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	updateData["allowed"] = allowed
 
-	// Actualizar guest_properties si se especifican viewers
+	// Actualizar guest_properties si se especifican viewers, fullscreen o credenciales
+	var viewers []string
 	if !plan.Viewers.IsNull() && !plan.Viewers.IsUnknown() {
-		var viewers []string
 		diags := plan.Viewers.ElementsAs(ctx, &viewers, false)
 		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() && len(viewers) > 0 {
-			viewersMap := make(map[string]interface{})
-			for _, viewer := range viewers {
-				viewersMap[viewer] = map[string]interface{}{"options": nil}
-			}
-			updateData["guest_properties"] = map[string]interface{}{
-				"viewers": viewersMap,
-			}
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
+	// La contraseña es write-only: solo se envía al configurar las
+	// credenciales o cuando cambia password_version
+	var password types.String
+	if credentialsPasswordChanged(plan.Credentials, state.Credentials) {
+		diags = req.Config.GetAttribute(ctx, path.Root("credentials").AtName("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	guestProperties, diags := buildGuestProperties(ctx, plan.Viewer, viewers, plan.Fullscreen, plan.Credentials, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Las guest_properties que se dejan de gestionar vuelven al valor del template
+	removed := removedGuestProperties(
+		managedGuestProperties{Viewers: len(plan.Viewer) > 0 || len(plan.Viewers.Elements()) > 0, Fullscreen: !plan.Fullscreen.IsNull(), Credentials: plan.Credentials != nil},
+		managedGuestProperties{Viewers: len(state.Viewer) > 0 || len(state.Viewers.Elements()) > 0, Fullscreen: !state.Fullscreen.IsNull(), Credentials: state.Credentials != nil},
+	)
+	if len(removed) > 0 {
+		template, err := r.client.GetTemplateInfo(plan.TemplateID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error leyendo el template",
+				fmt.Sprintf("No se pudo leer el template (ID: %s): %s", plan.TemplateID.ValueString(), err.Error()),
+			)
+			return
+		}
+		templateGuestProps, _ := template["guest_properties"].(map[string]interface{})
+		guestProperties = resetGuestProperties(guestProperties, templateGuestProps, removed)
+	}
+	if guestProperties != nil {
+		updateData["guest_properties"] = guestProperties
+	}
+
 	// Actualizar hardware si se especifica
 	if !plan.VCPUs.IsNull() || !plan.Memory.IsNull() || !plan.Interfaces.IsNull() {
 		hardware := make(map[string]interface{})
@@ -560,6 +461,38 @@ func (r *deploymentResource) Update(ctx context.Context, req resource.UpdateRequ
 		updateData["hardware"] = hardware
 	}
 
+	// Dejar de gestionar el perfil de vGPU libera la GPU de los desktops
+	var stateReservables *reservablesModel
+	diags = req.State.GetAttribute(ctx, path.Root("reservables"), &stateReservables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if reservables := reservablesUpdate(plan.Reservables, stateReservables); reservables != nil {
+		hardware, _ := updateData["hardware"].(map[string]interface{})
+		if hardware == nil {
+			hardware = make(map[string]interface{})
+			updateData["hardware"] = hardware
+		}
+		hardware["reservables"] = reservables
+	}
+
+	// Dejar de gestionar el QoS de disco vuelve al perfil sin límites
+	var stateQoSDiskID types.String
+	diags = req.State.GetAttribute(ctx, path.Root("qos_disk_id"), &stateQoSDiskID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if qosDiskID := qosDiskIDUpdate(plan.QoSDiskID, stateQoSDiskID); qosDiskID != "" {
+		hardware, _ := updateData["hardware"].(map[string]interface{})
+		if hardware == nil {
+			hardware = make(map[string]interface{})
+			updateData["hardware"] = hardware
+		}
+		hardware["qos_disk_id"] = qosDiskID
+	}
+
 	// Actualizar el deployment usando la API
 	err := r.client.UpdateDeployment(plan.ID.ValueString(), updateData)
 	if err != nil {
@@ -0,0 +1,415 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &groupResource{}
+	_ resource.ResourceWithConfigure = &groupResource{}
+)
+
+// NewGroupResource is a helper function to simplify the provider implementation.
+func NewGroupResource() resource.Resource {
+	return &groupResource{}
+}
+
+// groupResource is the resource implementation.
+type groupResource struct {
+	client *client.Client
+}
+
+// groupResourceModel maps the resource schema data.
+type groupResourceModel struct {
+	ID             types.String          `tfsdk:"id"`
+	Name           types.String          `tfsdk:"name"`
+	Description    types.String          `tfsdk:"description"`
+	ParentCategory types.String          `tfsdk:"parent_category"`
+	LinkedGroups   types.List            `tfsdk:"linked_groups"`
+	Quota          *quotaModel           `tfsdk:"quota"`
+	Limits         *quotaModel           `tfsdk:"limits"`
+	Enrollment     *groupEnrollmentModel `tfsdk:"enrollment"`
+}
+
+// groupEnrollmentModel agrupa los códigos de inscripción por rol.
+// Un rol omitido (nil) tiene la inscripción deshabilitada.
+type groupEnrollmentModel struct {
+	Advanced *enrollmentCodeModel `tfsdk:"advanced"`
+	Manager  *enrollmentCodeModel `tfsdk:"manager"`
+	User     *enrollmentCodeModel `tfsdk:"user"`
+}
+
+// enrollmentCodeModel representa el código de inscripción de un rol
+type enrollmentCodeModel struct {
+	RotateTrigger types.String `tfsdk:"rotate_trigger"`
+	Code          types.String `tfsdk:"code"`
+}
+
+// enrollmentRoles son los roles que admiten código de inscripción
+var enrollmentRoles = []string{"advanced", "manager", "user"}
+
+// roles relaciona cada rol de la API con su campo del modelo
+func (m *groupEnrollmentModel) roles() map[string]**enrollmentCodeModel {
+	return map[string]**enrollmentCodeModel{
+		"advanced": &m.Advanced,
+		"manager":  &m.Manager,
+		"user":     &m.User,
+	}
+}
+
+// role devuelve el código configurado para un rol (nil si está deshabilitado)
+func (m *groupEnrollmentModel) role(role string) *enrollmentCodeModel {
+	if m == nil {
+		return nil
+	}
+	return *m.roles()[role]
+}
+
+// Metadata returns the resource type name.
+func (r *groupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	enrollmentRole := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:            true,
+			MarkdownDescription: description,
+			Attributes: map[string]schema.Attribute{
+				"rotate_trigger": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Valor arbitrario. Al cambiarlo se genera un nuevo código",
+				},
+				"code": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "Código de inscripción generado por Isard",
+					PlanModifiers: []planmodifier.String{
+						rotateTriggerPlanModifier{},
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un grupo de usuarios en Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador único del grupo",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Nombre del grupo",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Descripción del grupo",
+			},
+			"parent_category": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID de la categoría a la que pertenece el grupo",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"linked_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "IDs de grupos enlazados cuyos recursos compartidos también ven los miembros de este grupo",
+			},
+			"quota":  quotaAttributeSchema("Cuota por usuario del grupo. Si se omite, se hereda la de la categoría."),
+			"limits": quotaAttributeSchema("Límites totales del grupo. Si se omite, se heredan los de la categoría."),
+			"enrollment": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Códigos de inscripción por rol. Un rol omitido tiene la inscripción deshabilitada.",
+				Attributes: map[string]schema.Attribute{
+					"advanced": enrollmentRole("Código de inscripción para el rol advanced"),
+					"manager":  enrollmentRole("Código de inscripción para el rol manager"),
+					"user":     enrollmentRole("Código de inscripción para el rol user"),
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *groupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkedGroups := []string{}
+	if !plan.LinkedGroups.IsNull() && !plan.LinkedGroups.IsUnknown() {
+		diags = plan.LinkedGroups.ElementsAs(ctx, &linkedGroups, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	group := &client.Group{
+		Name:           plan.Name.ValueString(),
+		Description:    plan.Description.ValueString(),
+		ParentCategory: plan.ParentCategory.ValueString(),
+		LinkedGroups:   linkedGroups,
+		Quota:          quotaToAPI(plan.Quota),
+		Limits:         quotaToAPI(plan.Limits),
+	}
+
+	groupID, err := r.client.CreateGroup(group)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando grupo",
+			"No se pudo crear el grupo: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(groupID)
+
+	// Habilitar los códigos de inscripción configurados
+	for _, role := range enrollmentRoles {
+		if plan.Enrollment.role(role) == nil {
+			continue
+		}
+		if _, err := r.client.SetGroupEnrollment(groupID, role, "reset"); err != nil {
+			resp.Diagnostics.AddError(
+				"Error generando código de inscripción",
+				"No se pudo generar el código de inscripción para el rol "+role+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Leer el grupo creado para obtener los valores computados
+	created, err := r.client.GetGroup(groupID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo grupo creado",
+			"No se pudo leer el grupo recién creado: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group, err := r.client.GetGroup(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "group not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo grupo",
+			"No se pudo leer el grupo ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(group.Name)
+	state.ParentCategory = types.StringValue(group.ParentCategory)
+
+	// linked_groups: mantener null si no se gestiona y la API no devuelve ninguno
+	if len(group.LinkedGroups) > 0 || !state.LinkedGroups.IsNull() {
+		linkedGroups, diags := types.ListValueFrom(ctx, types.StringType, group.LinkedGroups)
+		resp.Diagnostics.Append(diags...)
+		state.LinkedGroups = linkedGroups
+	}
+
+	r.mapComputed(group, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state groupResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	linkedGroups := []string{}
+	if !plan.LinkedGroups.IsNull() && !plan.LinkedGroups.IsUnknown() {
+		diags = plan.LinkedGroups.ElementsAs(ctx, &linkedGroups, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updateData := map[string]interface{}{
+		"name":          plan.Name.ValueString(),
+		"linked_groups": linkedGroups,
+	}
+	setQuotaUpdate(updateData, "quota", plan.Quota, state.Quota)
+	setQuotaUpdate(updateData, "limits", plan.Limits, state.Limits)
+	if !plan.Description.IsUnknown() {
+		updateData["description"] = plan.Description.ValueString()
+	}
+
+	err := r.client.UpdateGroup(plan.ID.ValueString(), updateData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando grupo",
+			"No se pudo actualizar el grupo ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Generar, rotar o deshabilitar los códigos de inscripción que cambian
+	for _, role := range enrollmentRoles {
+		planned := plan.Enrollment.role(role)
+		current := state.Enrollment.role(role)
+
+		action := ""
+		switch {
+		case planned == nil && current != nil:
+			action = "disable"
+		case planned != nil && (current == nil || !planned.RotateTrigger.Equal(current.RotateTrigger)):
+			action = "reset"
+		}
+		if action == "" {
+			continue
+		}
+
+		if _, err := r.client.SetGroupEnrollment(plan.ID.ValueString(), role, action); err != nil {
+			resp.Diagnostics.AddError(
+				"Error actualizando código de inscripción",
+				"No se pudo actualizar el código de inscripción para el rol "+role+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Leer el grupo actualizado para obtener los valores computados
+	updated, err := r.client.GetGroup(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo grupo actualizado",
+			"No se pudo leer el grupo actualizado: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteGroup(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando grupo",
+			"No se pudo eliminar el grupo ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// mapComputed copia al modelo los valores computados (descripción, cuota,
+// límites y códigos de inscripción) devueltos por la API
+func (r *groupResource) mapComputed(group *client.Group, model *groupResourceModel) {
+	model.Description = types.StringValue(group.Description)
+
+	// Cuota y límites solo se reflejan si se gestionan desde Terraform
+	if model.Quota != nil {
+		model.Quota = quotaFromAPI(group.Quota)
+	}
+	if model.Limits != nil {
+		model.Limits = quotaFromAPI(group.Limits)
+	}
+
+	// Códigos de inscripción: se conserva rotate_trigger del modelo
+	enrollment := &groupEnrollmentModel{}
+	enabled := false
+	for role, field := range enrollment.roles() {
+		code := group.EnrollmentCode(role)
+		if code == "" {
+			continue
+		}
+		enabled = true
+
+		rotateTrigger := types.StringNull()
+		if current := model.Enrollment.role(role); current != nil {
+			rotateTrigger = current.RotateTrigger
+		}
+		*field = &enrollmentCodeModel{
+			RotateTrigger: rotateTrigger,
+			Code:          types.StringValue(code),
+		}
+	}
+	if enabled || model.Enrollment != nil {
+		model.Enrollment = enrollment
+	}
+}
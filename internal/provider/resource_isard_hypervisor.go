@@ -0,0 +1,466 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &hypervisorResource{}
+	_ resource.ResourceWithConfigure   = &hypervisorResource{}
+	_ resource.ResourceWithImportState = &hypervisorResource{}
+)
+
+const (
+	// hypervisorDrainPollInterval es el intervalo entre consultas de los desktops arrancados al drenar
+	hypervisorDrainPollInterval = 15 * time.Second
+)
+
+// NewHypervisorResource is a helper function to simplify the provider implementation.
+func NewHypervisorResource() resource.Resource {
+	return &hypervisorResource{}
+}
+
+// hypervisorResource is the resource implementation.
+type hypervisorResource struct {
+	client *client.Client
+}
+
+// hypervisorResourceModel maps the resource schema data.
+type hypervisorResourceModel struct {
+	ID                types.String                 `tfsdk:"id"`
+	Hostname          types.String                 `tfsdk:"hostname"`
+	Port              types.Int64                  `tfsdk:"port"`
+	User              types.String                 `tfsdk:"user"`
+	Capabilities      *hypervisorCapabilitiesModel `tfsdk:"capabilities"`
+	ViewerHostname    types.String                 `tfsdk:"viewer_hostname"`
+	ViewerNATHostname types.String                 `tfsdk:"viewer_nat_hostname"`
+	ViewerNATOffset   types.Int64                  `tfsdk:"viewer_nat_offset"`
+	OnlyForced        types.Bool                   `tfsdk:"only_forced"`
+	MinFreeMemGB      types.Int64                  `tfsdk:"min_free_mem_gb"`
+	GPUEnabled        types.Bool                   `tfsdk:"gpu_enabled"`
+	Enabled           types.Bool                   `tfsdk:"enabled"`
+	DrainOnDestroy    types.Bool                   `tfsdk:"drain_on_destroy"`
+	DrainTimeout      types.Int64                  `tfsdk:"drain_timeout"`
+	Status            types.String                 `tfsdk:"status"`
+}
+
+// hypervisorCapabilitiesModel indica qué operaciones puede realizar el hipervisor
+type hypervisorCapabilitiesModel struct {
+	DiskOperations types.Bool `tfsdk:"disk_operations"`
+	Virtualization types.Bool `tfsdk:"virtualization"`
+}
+
+// toClient convierte el modelo a la estructura del cliente
+func (m *hypervisorResourceModel) toClient() *client.Hypervisor {
+	hypervisor := &client.Hypervisor{
+		Hostname:          m.Hostname.ValueString(),
+		Port:              m.Port.ValueInt64(),
+		User:              m.User.ValueString(),
+		Enabled:           m.Enabled.ValueBool(),
+		OnlyForced:        m.OnlyForced.ValueBool(),
+		MinFreeMemGB:      m.MinFreeMemGB.ValueInt64(),
+		GPUEnabled:        m.GPUEnabled.ValueBool(),
+		ViewerHostname:    m.ViewerHostname.ValueString(),
+		ViewerNATHostname: m.ViewerNATHostname.ValueString(),
+		ViewerNATOffset:   m.ViewerNATOffset.ValueInt64(),
+	}
+
+	// Sin viewer propio los clientes se conectan al hostname del hipervisor
+	if m.ViewerHostname.IsNull() || m.ViewerHostname.IsUnknown() {
+		hypervisor.ViewerHostname = hypervisor.Hostname
+	}
+	if m.ViewerNATHostname.IsNull() || m.ViewerNATHostname.IsUnknown() {
+		hypervisor.ViewerNATHostname = hypervisor.ViewerHostname
+	}
+
+	if m.Capabilities != nil {
+		hypervisor.DiskOperations = m.Capabilities.DiskOperations.ValueBool()
+		hypervisor.Virtualization = m.Capabilities.Virtualization.ValueBool()
+	}
+
+	return hypervisor
+}
+
+// refresh actualiza el modelo con los valores del hipervisor en la API
+func (m *hypervisorResourceModel) refresh(hypervisor *client.Hypervisor) {
+	m.ID = types.StringValue(hypervisor.ID)
+	m.Hostname = types.StringValue(hypervisor.Hostname)
+	m.Port = types.Int64Value(hypervisor.Port)
+	m.User = types.StringValue(hypervisor.User)
+	m.Capabilities = &hypervisorCapabilitiesModel{
+		DiskOperations: types.BoolValue(hypervisor.DiskOperations),
+		Virtualization: types.BoolValue(hypervisor.Virtualization),
+	}
+	m.ViewerHostname = types.StringValue(hypervisor.ViewerHostname)
+	m.ViewerNATHostname = types.StringValue(hypervisor.ViewerNATHostname)
+	m.ViewerNATOffset = types.Int64Value(hypervisor.ViewerNATOffset)
+	m.OnlyForced = types.BoolValue(hypervisor.OnlyForced)
+	m.MinFreeMemGB = types.Int64Value(hypervisor.MinFreeMemGB)
+	m.GPUEnabled = types.BoolValue(hypervisor.GPUEnabled)
+	m.Enabled = types.BoolValue(hypervisor.Enabled)
+	m.Status = types.StringValue(hypervisor.Status)
+}
+
+// Metadata returns the resource type name.
+func (r *hypervisorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hypervisor"
+}
+
+// Schema defines the schema for the resource.
+func (r *hypervisorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	capabilityTypes := map[string]attr.Type{
+		"disk_operations": types.BoolType,
+		"virtualization":  types.BoolType,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un hipervisor de Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID único del hipervisor (por ejemplo, isard-hypervisor).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(adminTableIDRegexp, "solo puede contener letras, números, '.', '_' y '-'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Hostname o IP por el que Isard se conecta al hipervisor.",
+				Required:    true,
+			},
+			"port": schema.Int64Attribute{
+				Description: "Puerto SSH del hipervisor (por defecto: 2022).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(2022),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "Usuario SSH del hipervisor (por defecto: root).",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString("root"),
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				Description: "Operaciones que puede realizar el hipervisor (por defecto: ambas).",
+				Optional:    true,
+				Computed:    true,
+				Default: objectdefault.StaticValue(types.ObjectValueMust(capabilityTypes, map[string]attr.Value{
+					"disk_operations": types.BoolValue(true),
+					"virtualization":  types.BoolValue(true),
+				})),
+				Attributes: map[string]schema.Attribute{
+					"disk_operations": schema.BoolAttribute{
+						Description: "Si el hipervisor realiza operaciones de disco (por defecto: true).",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+					"virtualization": schema.BoolAttribute{
+						Description: "Si el hipervisor arranca desktops (por defecto: true).",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(true),
+					},
+				},
+			},
+			"viewer_hostname": schema.StringAttribute{
+				Description: "Hostname al que se conectan los viewers. Si se omite, se usa hostname.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"viewer_nat_hostname": schema.StringAttribute{
+				Description: "Hostname de los viewers detrás de NAT. Si se omite, se usa viewer_hostname.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"viewer_nat_offset": schema.Int64Attribute{
+				Description: "Desplazamiento de puertos de los viewers detrás de NAT (por defecto: 0).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"only_forced": schema.BoolAttribute{
+				Description: "Si solo arranca desktops que lo fuerzan explícitamente (por defecto: false).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"min_free_mem_gb": schema.Int64Attribute{
+				Description: "Memoria libre mínima en GB para arrancar nuevos desktops (por defecto: 0).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"gpu_enabled": schema.BoolAttribute{
+				Description: "Si el hipervisor ofrece GPUs NVIDIA (vGPU) (por defecto: false).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Si el hipervisor está habilitado para arrancar desktops (por defecto: true).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"drain_on_destroy": schema.BoolAttribute{
+				Description: "Si al destruirlo se deshabilita y se espera a que no quede ningún desktop arrancado antes de eliminarlo (por defecto: false).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"drain_timeout": schema.Int64Attribute{
+				Description: "Minutos máximos de espera del drenado (por defecto: 30).",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Estado del hipervisor (Online, Offline, Error...).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *hypervisorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *hypervisorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan hypervisorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisorID := plan.ID.ValueString()
+	err := r.client.CreateHypervisor(hypervisorID, plan.toClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando hipervisor",
+			"No se pudo crear el hipervisor "+hypervisorID+": "+err.Error(),
+		)
+		return
+	}
+
+	hypervisor, err := r.client.GetHypervisor(hypervisorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo hipervisor",
+			"No se pudo leer el hipervisor "+hypervisorID+" tras crearlo: "+err.Error(),
+		)
+		return
+	}
+
+	plan.refresh(hypervisor)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *hypervisorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state hypervisorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisor, err := r.client.GetHypervisor(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "hypervisor not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo hipervisor",
+			"No se pudo leer el hipervisor ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.refresh(hypervisor)
+
+	// Tras importar, las opciones de destrucción toman su valor por defecto
+	if state.DrainOnDestroy.IsNull() {
+		state.DrainOnDestroy = types.BoolValue(false)
+	}
+	if state.DrainTimeout.IsNull() {
+		state.DrainTimeout = types.Int64Value(30)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *hypervisorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan hypervisorResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisorID := plan.ID.ValueString()
+	err := r.client.UpdateHypervisorSpec(hypervisorID, plan.toClient())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando hipervisor",
+			"No se pudo actualizar el hipervisor ID "+hypervisorID+": "+err.Error(),
+		)
+		return
+	}
+
+	hypervisor, err := r.client.GetHypervisor(hypervisorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo hipervisor",
+			"No se pudo leer el hipervisor ID "+hypervisorID+" tras actualizarlo: "+err.Error(),
+		)
+		return
+	}
+
+	plan.refresh(hypervisor)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *hypervisorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state hypervisorResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hypervisorID := state.ID.ValueString()
+
+	if state.DrainOnDestroy.ValueBool() {
+		// Deshabilitarlo para que no arranque nuevos desktops mientras se vacía
+		err := r.client.UpdateHypervisor(hypervisorID, map[string]interface{}{"enabled": false})
+		if err != nil {
+			if err.Error() == "hypervisor not found" {
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error deshabilitando hipervisor",
+				"No se pudo deshabilitar el hipervisor ID "+hypervisorID+": "+err.Error(),
+			)
+			return
+		}
+
+		timeout := time.Duration(state.DrainTimeout.ValueInt64()) * time.Minute
+		if err := r.waitForDrain(ctx, hypervisorID, timeout); err != nil {
+			resp.Diagnostics.AddError(
+				"Error drenando hipervisor",
+				"El hipervisor "+hypervisorID+" se ha deshabilitado pero no se ha eliminado: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err := r.client.DeleteHypervisor(hypervisorID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando hipervisor",
+			"No se pudo eliminar el hipervisor ID "+hypervisorID+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState importa un hipervisor por su ID.
+func (r *hypervisorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// waitForDrain consulta el hipervisor hasta que no le queda ningún desktop arrancado
+func (r *hypervisorResource) waitForDrain(ctx context.Context, hypervisorID string, timeout time.Duration) error {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(hypervisorDrainPollInterval)
+	defer ticker.Stop()
+
+	for {
+		hypervisor, err := r.client.GetHypervisor(hypervisorID)
+		if err != nil {
+			if err.Error() == "hypervisor not found" {
+				return nil
+			}
+			return err
+		}
+
+		if hypervisor.DesktopsStarted == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-deadline:
+			return fmt.Errorf("siguen arrancados %d desktops tras %s", hypervisor.DesktopsStarted, timeout)
+		case <-ticker.C:
+		}
+	}
+}
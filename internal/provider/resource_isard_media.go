@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &mediaResource{}
+	_ resource.ResourceWithConfigure = &mediaResource{}
+)
+
+const (
+	// mediaPollInterval es el intervalo entre consultas del estado de descarga
+	mediaPollInterval = 10 * time.Second
+	// mediaDownloadTimeout es el tiempo máximo de espera de una descarga
+	mediaDownloadTimeout = 2 * time.Hour
+)
+
+// mediaKinds son los tipos de medio soportados
+var mediaKinds = []string{"iso", "floppy"}
+
+// NewMediaResource is a helper function to simplify the provider implementation.
+func NewMediaResource() resource.Resource {
+	return &mediaResource{}
+}
+
+// mediaResource is the resource implementation.
+type mediaResource struct {
+	client *client.Client
+}
+
+// mediaResourceModel maps the resource schema data.
+type mediaResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	URL         types.String  `tfsdk:"url"`
+	Kind        types.String  `tfsdk:"kind"`
+	Status      types.String  `tfsdk:"status"`
+	User        types.String  `tfsdk:"user"`
+	Allowed     *allowedModel `tfsdk:"allowed"`
+}
+
+// Metadata returns the resource type name.
+func (r *mediaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_media"
+}
+
+// Schema defines the schema for the resource.
+func (r *mediaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un medio (ISO o floppy) que Isard VDI descarga desde una URL.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID único del medio (generado automáticamente).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Nombre del medio.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Descripción del medio.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: "URL desde la que Isard descarga el medio.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Description: "Tipo de medio (iso, floppy).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(mediaKinds...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Estado de la descarga (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Description: "ID del usuario propietario del medio (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"allowed": allowedBlockSchema("Permisos de acceso al medio. Si se omite, el medio solo es visible para su propietario."),
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *mediaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *mediaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan mediaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// allowed por defecto: solo propietario
+	allowed := ownerOnlyAllowed()
+	if plan.Allowed != nil {
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	mediaID, err := r.client.CreateMedia(
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.URL.ValueString(),
+		plan.Kind.ValueString(),
+		allowed,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando medio",
+			"No se pudo registrar el medio: "+err.Error(),
+		)
+		return
+	}
+
+	// Esperar a que termine la descarga
+	media, err := r.waitForDownload(ctx, mediaID)
+	if err != nil {
+		// Guardar el ID para que Terraform marque el recurso como tainted y lo elimine
+		plan.ID = types.StringValue(mediaID)
+		plan.Description = types.StringValue(plan.Description.ValueString())
+		plan.Status = types.StringValue(client.MediaStatusFailed)
+		plan.User = types.StringValue("")
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError(
+			"Error descargando medio",
+			"El medio "+mediaID+" no se pudo descargar: "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan.ID = types.StringValue(media.ID)
+	plan.Description = types.StringValue(media.Description)
+	plan.Status = types.StringValue(media.Status)
+	plan.User = types.StringValue(media.User)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *mediaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state mediaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	media, err := r.client.GetMedia(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "media not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo medio",
+			"No se pudo leer el medio ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state.Name = types.StringValue(media.Name)
+	state.Description = types.StringValue(media.Description)
+	state.Kind = types.StringValue(media.Kind)
+	state.Status = types.StringValue(media.Status)
+	state.User = types.StringValue(media.User)
+	if media.URL != "" {
+		state.URL = types.StringValue(media.URL)
+	}
+
+	// Solo se refleja allowed si está gestionado o si el medio está compartido
+	if state.Allowed != nil || allowedIsShared(media.Allowed) {
+		allowed, diags := allowedFromAPI(ctx, media.Allowed)
+		resp.Diagnostics.Append(diags...)
+		state.Allowed = allowed
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Solo allowed se puede modificar; el resto de atributos fuerzan la recreación.
+func (r *mediaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan mediaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// allowed se envía siempre: omitir el bloque vuelve a dejar el medio solo para el propietario
+	allowed := ownerOnlyAllowed()
+	if plan.Allowed != nil {
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.client.UpdateMedia(plan.ID.ValueString(), map[string]interface{}{
+		"allowed": allowed,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando medio",
+			"No se pudo actualizar el medio ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *mediaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state mediaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMedia(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando medio",
+			"No se pudo eliminar el medio ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// waitForDownload consulta el estado del medio hasta que termina la descarga
+func (r *mediaResource) waitForDownload(ctx context.Context, mediaID string) (*client.Media, error) {
+	deadline := time.After(mediaDownloadTimeout)
+	ticker := time.NewTicker(mediaPollInterval)
+	defer ticker.Stop()
+
+	for {
+		media, err := r.client.GetMedia(mediaID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch media.Status {
+		case client.MediaStatusDownloaded:
+			return media, nil
+		case client.MediaStatusFailed, "Failed":
+			return nil, fmt.Errorf("la descarga terminó con estado %s", media.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, fmt.Errorf("la descarga no terminó en %s (último estado: %s)", mediaDownloadTimeout, media.Status)
+		case <-ticker.C:
+		}
+	}
+}
@@ -30,12 +30,18 @@ type networkResource struct {
 
 // networkResourceModel maps the resource schema data.
 type networkResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	Model       types.String `tfsdk:"model"`
-	QoSID       types.String `tfsdk:"qos_id"`
-	MetadataID  types.String `tfsdk:"metadata_id"`
+	ID          types.String  `tfsdk:"id"`
+	Name        types.String  `tfsdk:"name"`
+	Description types.String  `tfsdk:"description"`
+	Model       types.String  `tfsdk:"model"`
+	QoSID       types.String  `tfsdk:"qos_id"`
+	MetadataID  types.String  `tfsdk:"metadata_id"`
+	User        types.String  `tfsdk:"user"`
+	Group       types.String  `tfsdk:"group"`
+	Category    types.String  `tfsdk:"category"`
+	Created     types.String  `tfsdk:"created"`
+	Modified    types.String  `tfsdk:"modified"`
+	Allowed     *allowedModel `tfsdk:"allowed"`
 }
 
 // Metadata returns the resource type name.
@@ -83,6 +89,41 @@ func (r *networkResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "ID de metadata generado para OpenFlow (solo lectura).",
 				Computed:    true,
 			},
+			"user": schema.StringAttribute{
+				Description: "ID del usuario propietario de la red (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Description: "ID del grupo del propietario (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"category": schema.StringAttribute{
+				Description: "ID de la categoría del propietario (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created": schema.StringAttribute{
+				Description: "Fecha de creación de la red (solo lectura).",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				Description: "Fecha de la última modificación de la red (solo lectura).",
+				Computed:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"allowed": allowedBlockSchema("Permisos de acceso a la red. Si se omite, la red solo es visible para su propietario."),
 		},
 	}
 }
@@ -122,11 +163,13 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	qosID := plan.QoSID.ValueString()
 
 	// allowed por defecto: solo propietario
-	allowed := map[string]interface{}{
-		"roles":      false,
-		"categories": false,
-		"groups":     false,
-		"users":      []interface{}{},
+	allowed := ownerOnlyAllowed()
+	if plan.Allowed != nil {
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	networkID, err := r.client.CreateNetwork(
@@ -159,6 +202,11 @@ func (r *networkResource) Create(ctx context.Context, req resource.CreateRequest
 	plan.Model = types.StringValue(network.Model)
 	plan.QoSID = types.StringValue(network.QoSID)
 	plan.MetadataID = types.StringValue(network.MetadataID)
+	plan.User = types.StringValue(network.User)
+	plan.Group = types.StringValue(network.Group)
+	plan.Category = types.StringValue(network.Category)
+	plan.Created = types.StringValue(network.Created)
+	plan.Modified = types.StringValue(network.Modified)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -198,6 +246,19 @@ func (r *networkResource) Read(ctx context.Context, req resource.ReadRequest, re
 	state.Model = types.StringValue(network.Model)
 	state.QoSID = types.StringValue(network.QoSID)
 	state.MetadataID = types.StringValue(network.MetadataID)
+	state.User = types.StringValue(network.User)
+	state.Group = types.StringValue(network.Group)
+	state.Category = types.StringValue(network.Category)
+	state.Created = types.StringValue(network.Created)
+	state.Modified = types.StringValue(network.Modified)
+
+	// Solo se refleja allowed si está gestionado o si la red está compartida,
+	// para no generar diferencias cuando el bloque se omite
+	if state.Allowed != nil || allowedIsShared(network.Allowed) {
+		allowed, diags := allowedFromAPI(ctx, network.Allowed)
+		resp.Diagnostics.Append(diags...)
+		state.Allowed = allowed
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -243,13 +304,23 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 		qosID = &q
 	}
 
+	// allowed se envía siempre: omitir el bloque vuelve a dejar la red solo para el propietario
+	allowed := ownerOnlyAllowed()
+	if plan.Allowed != nil {
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Actualizar la red
 	err := r.client.UpdateNetwork(
 		plan.ID.ValueString(),
 		name,
 		description,
 		qosID,
-		nil, // allowed no se actualiza por ahora
+		allowed,
 	)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -275,6 +346,11 @@ func (r *networkResource) Update(ctx context.Context, req resource.UpdateRequest
 	plan.Model = types.StringValue(network.Model)
 	plan.QoSID = types.StringValue(network.QoSID)
 	plan.MetadataID = types.StringValue(network.MetadataID)
+	plan.User = types.StringValue(network.User)
+	plan.Group = types.StringValue(network.Group)
+	plan.Category = types.StringValue(network.Category)
+	plan.Created = types.StringValue(network.Created)
+	plan.Modified = types.StringValue(network.Modified)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -3,19 +3,27 @@ package provider
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tknika/terraform-provider-isard/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &networkInterfaceResource{}
-	_ resource.ResourceWithConfigure = &networkInterfaceResource{}
+	_ resource.Resource                     = &networkInterfaceResource{}
+	_ resource.ResourceWithConfigure        = &networkInterfaceResource{}
+	_ resource.ResourceWithConfigValidators = &networkInterfaceResource{}
+	_ resource.ResourceWithValidateConfig   = &networkInterfaceResource{}
+	_ resource.ResourceWithModifyPlan       = &networkInterfaceResource{}
 )
 
 // NewNetworkInterfaceResource is a helper function to simplify the provider implementation.
@@ -28,14 +36,6 @@ type networkInterfaceResource struct {
 	client *client.Client
 }
 
-// AllowedModel representa los permisos de acceso a la interfaz
-type AllowedModel struct {
-	Roles      types.List `tfsdk:"roles"`
-	Categories types.List `tfsdk:"categories"`
-	Groups     types.List `tfsdk:"groups"`
-	Users      types.List `tfsdk:"users"`
-}
-
 // networkInterfaceResourceModel maps the resource schema data.
 type networkInterfaceResourceModel struct {
 	ID          types.String  `tfsdk:"id"`
@@ -46,7 +46,13 @@ type networkInterfaceResourceModel struct {
 	Model       types.String  `tfsdk:"model"`
 	QoSID       types.String  `tfsdk:"qos_id"`
 	Ifname      types.String  `tfsdk:"ifname"`
-	Allowed     *AllowedModel `tfsdk:"allowed"`
+	Allowed     *allowedModel `tfsdk:"allowed"`
+
+	// Atributos específicos de cada tipo (alternativa tipada a ifname)
+	Bridge   *bridgeInterfaceModel      `tfsdk:"bridge"`
+	Network  *networkInterfaceKindModel `tfsdk:"network"`
+	OVS      *ovsInterfaceModel         `tfsdk:"ovs"`
+	Personal *personalInterfaceModel    `tfsdk:"personal"`
 }
 
 // Metadata returns the resource type name.
@@ -79,17 +85,23 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				Required:    true,
 			},
 			"kind": schema.StringAttribute{
-				Description: "Tipo de interfaz (ej. 'bridge', 'network').",
+				Description: "Tipo de interfaz (bridge, network, ovs, personal). Si se omite y se usa un atributo específico del tipo, se deduce de él.",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(interfaceKinds...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"model": schema.StringAttribute{
-				Description: "Modelo de interfaz de red (por defecto: 'virtio').",
+				Description: "Modelo de interfaz de red (virtio, e1000, rtl8139; por defecto: 'virtio').",
 				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(interfaceModels...),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -103,38 +115,165 @@ func (r *networkInterfaceResource) Schema(_ context.Context, _ resource.SchemaRe
 				},
 			},
 			"ifname": schema.StringAttribute{
-				Description: "Opcion secundaria según el tipo de interfaz.",
+				Description: "Opción específica del tipo de interfaz: interfaz Linux (bridge), red libvirt (network), VLAN (ovs) o rango de VLANs 'inicio-fin' (personal). Se calcula a partir del atributo específico del tipo si se usa.",
 				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 		},
 		Blocks: map[string]schema.Block{
-			"allowed": schema.SingleNestedBlock{
-				Description: "Permisos de acceso a la interfaz. Use listas vacías para permitir acceso a todos.",
-				Attributes: map[string]schema.Attribute{
-					"roles": schema.ListAttribute{
-						Description: "Lista de IDs de roles permitidos. Lista vacía = todos los roles. Omitir = sin restricción.",
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"categories": schema.ListAttribute{
-						Description: "Lista de IDs de categorías permitidas. Lista vacía = todas las categorías.",
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"groups": schema.ListAttribute{
-						Description: "Lista de IDs de grupos permitidos. Lista vacía = todos los grupos.",
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-					"users": schema.ListAttribute{
-						Description: "Lista de IDs de usuarios permitidos. Lista vacía = todos los usuarios.",
-						ElementType: types.StringType,
-						Optional:    true,
-					},
-				},
-			},
+			"allowed": allowedBlockSchema("Permisos de acceso a la interfaz. Use listas vacías para permitir acceso a todos."),
 		},
 	}
+
+	// Atributos específicos de cada tipo de interfaz
+	for name, attribute := range interfaceKindAttributes() {
+		resp.Schema.Attributes[name] = attribute
+	}
+}
+
+// ConfigValidators impide mezclar ifname con los atributos específicos de cada tipo.
+func (r *networkInterfaceResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("ifname"),
+			path.MatchRoot("bridge"),
+			path.MatchRoot("network"),
+			path.MatchRoot("ovs"),
+			path.MatchRoot("personal"),
+		),
+	}
+}
+
+// ValidateConfig comprueba que ifname y model sean válidos para el tipo de interfaz.
+func (r *networkInterfaceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kind := config.Kind.ValueString()
+	ifname := config.Ifname.ValueString()
+	ifnameKnown := !config.Ifname.IsNull() && !config.Ifname.IsUnknown()
+
+	if specificKind, specificIfname, known := config.kindSpecific(); specificKind != "" {
+		if config.Kind.IsUnknown() {
+			return
+		}
+		if kind != "" && kind != specificKind {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(specificKind),
+				"Atributo no soportado",
+				fmt.Sprintf("El atributo %s solo se puede usar con kind = %q (kind configurado: %q).", specificKind, specificKind, kind),
+			)
+			return
+		}
+		kind = specificKind
+		ifname = specificIfname
+		ifnameKnown = known
+	}
+
+	if kind == "" || !ifnameKnown {
+		return
+	}
+
+	if err := validateInterfaceIfname(kind, ifname); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ifname"),
+			"Valor de ifname no válido",
+			err.Error(),
+		)
+		return
+	}
+
+	if config.Model.IsNull() || config.Model.IsUnknown() {
+		return
+	}
+	if err := validateInterfaceModel(kind, ifname, config.Model.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("model"),
+			"Modelo no soportado",
+			err.Error(),
+		)
+	}
+}
+
+// ModifyPlan calcula kind e ifname a partir del atributo específico del tipo.
+func (r *networkInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nada que calcular al destruir el recurso
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan networkInterfaceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if kind, ifname, known := plan.kindSpecific(); kind != "" {
+		// ValidateConfig garantiza que un kind configurado coincide con el del atributo
+		plan.Kind = types.StringValue(kind)
+		plan.Ifname = types.StringUnknown()
+		if known {
+			plan.Ifname = types.StringValue(ifname)
+		}
+
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.checkVLANOverlaps(ctx, req, &plan, resp)
+}
+
+// checkVLANOverlaps avisa en el plan si las VLANs de una interfaz ovs o personal
+// se solapan con las de otra interfaz existente. Solo se comprueba al crear la
+// interfaz o al cambiar sus VLANs.
+func (r *networkInterfaceResource) checkVLANOverlaps(ctx context.Context, req resource.ModifyPlanRequest, plan *networkInterfaceResourceModel, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || plan.Kind.IsUnknown() || plan.Ifname.IsUnknown() || plan.ID.IsUnknown() {
+		return
+	}
+
+	start, end, ok := interfaceVLANs(plan.Kind.ValueString(), plan.Ifname.ValueString())
+	if !ok {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state networkInterfaceResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Kind.Equal(state.Kind) && plan.Ifname.Equal(state.Ifname) {
+			return
+		}
+	}
+
+	interfaces, err := r.client.ListNetworkInterfaces()
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"No se pudieron comprobar las VLANs",
+			"No se pudo obtener la lista de interfaces de red para detectar solapamientos: "+err.Error(),
+		)
+		return
+	}
+
+	if overlaps := findVLANOverlaps(interfaces, plan.ID.ValueString(), start, end); len(overlaps) > 0 {
+		// Es un aviso y no un error: en el mismo apply se puede estar moviendo
+		// la VLAN desde otra interfaz que se modifica o destruye
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("ifname"),
+			"VLANs solapadas",
+			fmt.Sprintf("Las VLANs %s de la interfaz %s se solapan con otras interfaces: %s. Si no se liberan en este mismo apply, Isard rechazará la interfaz.",
+				plan.Ifname.ValueString(), plan.ID.ValueString(), strings.Join(overlaps, ", ")),
+		)
+	}
 }
 
 // Configure adds the provider configured client to the resource.
@@ -170,82 +309,10 @@ func (r *networkInterfaceResource) Create(ctx context.Context, req resource.Crea
 	// Construir el mapa allowed si está presente
 	var allowed map[string]interface{}
 	if plan.Allowed != nil {
-		allowed = make(map[string]interface{})
-		
-		// Roles
-		if !plan.Allowed.Roles.IsNull() {
-			var roles []string
-			diags = plan.Allowed.Roles.ElementsAs(ctx, &roles, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(roles) == 0 {
-				allowed["roles"] = []interface{}{}
-			} else {
-				rolesInterface := make([]interface{}, len(roles))
-				for i, r := range roles {
-					rolesInterface[i] = r
-				}
-				allowed["roles"] = rolesInterface
-			}
-		}
-		
-		// Categories
-		if !plan.Allowed.Categories.IsNull() {
-			var categories []string
-			diags = plan.Allowed.Categories.ElementsAs(ctx, &categories, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(categories) == 0 {
-				allowed["categories"] = []interface{}{}
-			} else {
-				categoriesInterface := make([]interface{}, len(categories))
-				for i, c := range categories {
-					categoriesInterface[i] = c
-				}
-				allowed["categories"] = categoriesInterface
-			}
-		}
-		
-		// Groups
-		if !plan.Allowed.Groups.IsNull() {
-			var groups []string
-			diags = plan.Allowed.Groups.ElementsAs(ctx, &groups, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(groups) == 0 {
-				allowed["groups"] = []interface{}{}
-			} else {
-				groupsInterface := make([]interface{}, len(groups))
-				for i, g := range groups {
-					groupsInterface[i] = g
-				}
-				allowed["groups"] = groupsInterface
-			}
-		}
-		
-		// Users
-		if !plan.Allowed.Users.IsNull() {
-			var users []string
-			diags = plan.Allowed.Users.ElementsAs(ctx, &users, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(users) == 0 {
-				allowed["users"] = []interface{}{}
-			} else {
-				usersInterface := make([]interface{}, len(users))
-				for i, u := range users {
-					usersInterface[i] = u
-				}
-				allowed["users"] = usersInterface
-			}
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -289,9 +356,8 @@ func (r *networkInterfaceResource) Create(ctx context.Context, req resource.Crea
 	if iface.QoSID != "" {
 		plan.QoSID = types.StringValue(iface.QoSID)
 	}
-	if iface.Ifname != "" {
-		plan.Ifname = types.StringValue(iface.Ifname)
-	}
+	plan.Ifname = types.StringValue(iface.Ifname)
+	plan.refreshKindSpecific(plan.Kind.ValueString(), iface.Ifname)
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -340,59 +406,14 @@ func (r *networkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 	if iface.QoSID != "" {
 		state.QoSID = types.StringValue(iface.QoSID)
 	}
-	if iface.Ifname != "" {
-		state.Ifname = types.StringValue(iface.Ifname)
-	}
+	state.Ifname = types.StringValue(iface.Ifname)
+	state.refreshKindSpecific(state.Kind.ValueString(), iface.Ifname)
 	
 	// Process allowed field if present
 	if iface.Allowed != nil {
-		allowedModel := &AllowedModel{}
-		
-		// Roles
-		if roles, ok := iface.Allowed["roles"].([]interface{}); ok {
-			rolesStr := make([]string, 0, len(roles))
-			for _, r := range roles {
-				if rs, ok := r.(string); ok {
-					rolesStr = append(rolesStr, rs)
-				}
-			}
-			allowedModel.Roles, _ = types.ListValueFrom(ctx, types.StringType, rolesStr)
-		}
-		
-		// Categories
-		if categories, ok := iface.Allowed["categories"].([]interface{}); ok {
-			categoriesStr := make([]string, 0, len(categories))
-			for _, c := range categories {
-				if cs, ok := c.(string); ok {
-					categoriesStr = append(categoriesStr, cs)
-				}
-			}
-			allowedModel.Categories, _ = types.ListValueFrom(ctx, types.StringType, categoriesStr)
-		}
-		
-		// Groups
-		if groups, ok := iface.Allowed["groups"].([]interface{}); ok {
-			groupsStr := make([]string, 0, len(groups))
-			for _, g := range groups {
-				if gs, ok := g.(string); ok {
-					groupsStr = append(groupsStr, gs)
-				}
-			}
-			allowedModel.Groups, _ = types.ListValueFrom(ctx, types.StringType, groupsStr)
-		}
-		
-		// Users
-		if users, ok := iface.Allowed["users"].([]interface{}); ok {
-			usersStr := make([]string, 0, len(users))
-			for _, u := range users {
-				if us, ok := u.(string); ok {
-					usersStr = append(usersStr, us)
-				}
-			}
-			allowedModel.Users, _ = types.ListValueFrom(ctx, types.StringType, usersStr)
-		}
-		
-		state.Allowed = allowedModel
+		allowed, diags := allowedFromAPI(ctx, iface.Allowed)
+		resp.Diagnostics.Append(diags...)
+		state.Allowed = allowed
 	}
 
 	// Set refreshed state
@@ -459,85 +480,13 @@ func (r *networkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		ifname = &i
 	}
 	
-	// Construir el mapa allowed si cambió
+	// Construir el mapa allowed si está presente
 	var allowed map[string]interface{}
 	if plan.Allowed != nil {
-		allowed = make(map[string]interface{})
-		
-		// Roles
-		if !plan.Allowed.Roles.IsNull() {
-			var roles []string
-			diags = plan.Allowed.Roles.ElementsAs(ctx, &roles, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(roles) == 0 {
-				allowed["roles"] = []interface{}{}
-			} else {
-				rolesInterface := make([]interface{}, len(roles))
-				for i, r := range roles {
-					rolesInterface[i] = r
-				}
-				allowed["roles"] = rolesInterface
-			}
-		}
-		
-		// Categories
-		if !plan.Allowed.Categories.IsNull() {
-			var categories []string
-			diags = plan.Allowed.Categories.ElementsAs(ctx, &categories, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(categories) == 0 {
-				allowed["categories"] = []interface{}{}
-			} else {
-				categoriesInterface := make([]interface{}, len(categories))
-				for i, c := range categories {
-					categoriesInterface[i] = c
-				}
-				allowed["categories"] = categoriesInterface
-			}
-		}
-		
-		// Groups
-		if !plan.Allowed.Groups.IsNull() {
-			var groups []string
-			diags = plan.Allowed.Groups.ElementsAs(ctx, &groups, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(groups) == 0 {
-				allowed["groups"] = []interface{}{}
-			} else {
-				groupsInterface := make([]interface{}, len(groups))
-				for i, g := range groups {
-					groupsInterface[i] = g
-				}
-				allowed["groups"] = groupsInterface
-			}
-		}
-		
-		// Users
-		if !plan.Allowed.Users.IsNull() {
-			var users []string
-			diags = plan.Allowed.Users.ElementsAs(ctx, &users, false)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-			if len(users) == 0 {
-				allowed["users"] = []interface{}{}
-			} else {
-				usersInterface := make([]interface{}, len(users))
-				for i, u := range users {
-					usersInterface[i] = u
-				}
-				allowed["users"] = usersInterface
-			}
+		allowed, diags = plan.Allowed.toAPI(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
 	}
 
@@ -586,59 +535,14 @@ func (r *networkInterfaceResource) Update(ctx context.Context, req resource.Upda
 	if iface.QoSID != "" {
 		plan.QoSID = types.StringValue(iface.QoSID)
 	}
-	if iface.Ifname != "" {
-		plan.Ifname = types.StringValue(iface.Ifname)
-	}
+	plan.Ifname = types.StringValue(iface.Ifname)
+	plan.refreshKindSpecific(plan.Kind.ValueString(), iface.Ifname)
 	
 	// Process allowed field if present
 	if iface.Allowed != nil {
-		allowedModel := &AllowedModel{}
-		
-		// Roles
-		if roles, ok := iface.Allowed["roles"].([]interface{}); ok {
-			rolesStr := make([]string, 0, len(roles))
-			for _, r := range roles {
-				if rs, ok := r.(string); ok {
-					rolesStr = append(rolesStr, rs)
-				}
-			}
-			allowedModel.Roles, _ = types.ListValueFrom(ctx, types.StringType, rolesStr)
-		}
-		
-		// Categories
-		if categories, ok := iface.Allowed["categories"].([]interface{}); ok {
-			categoriesStr := make([]string, 0, len(categories))
-			for _, c := range categories {
-				if cs, ok := c.(string); ok {
-					categoriesStr = append(categoriesStr, cs)
-				}
-			}
-			allowedModel.Categories, _ = types.ListValueFrom(ctx, types.StringType, categoriesStr)
-		}
-		
-		// Groups
-		if groups, ok := iface.Allowed["groups"].([]interface{}); ok {
-			groupsStr := make([]string, 0, len(groups))
-			for _, g := range groups {
-				if gs, ok := g.(string); ok {
-					groupsStr = append(groupsStr, gs)
-				}
-			}
-			allowedModel.Groups, _ = types.ListValueFrom(ctx, types.StringType, groupsStr)
-		}
-		
-		// Users
-		if users, ok := iface.Allowed["users"].([]interface{}); ok {
-			usersStr := make([]string, 0, len(users))
-			for _, u := range users {
-				if us, ok := u.(string); ok {
-					usersStr = append(usersStr, us)
-				}
-			}
-			allowedModel.Users, _ = types.ListValueFrom(ctx, types.StringType, usersStr)
-		}
-		
-		plan.Allowed = allowedModel
+		allowed, diags := allowedFromAPI(ctx, iface.Allowed)
+		resp.Diagnostics.Append(diags...)
+		plan.Allowed = allowed
 	}
 
 	diags = resp.State.Set(ctx, plan)
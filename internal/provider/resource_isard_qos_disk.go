@@ -0,0 +1,423 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &qosDiskResource{}
+	_ resource.ResourceWithConfigure      = &qosDiskResource{}
+	_ resource.ResourceWithValidateConfig = &qosDiskResource{}
+	_ resource.ResourceWithModifyPlan     = &qosDiskResource{}
+	_ resource.ResourceWithImportState    = &qosDiskResource{}
+)
+
+// qosDiskIOTuneFields son los límites de iotune de libvirt que gestiona el recurso.
+// Cada límite base tiene su pico con sufijo "_max".
+var qosDiskIOTuneFields = []string{
+	"read_bytes_sec",
+	"write_bytes_sec",
+	"read_iops_sec",
+	"write_iops_sec",
+}
+
+// NewQoSDiskResource is a helper function to simplify the provider implementation.
+func NewQoSDiskResource() resource.Resource {
+	return &qosDiskResource{}
+}
+
+// qosDiskResource is the resource implementation.
+type qosDiskResource struct {
+	client *client.Client
+}
+
+// qosDiskResourceModel maps the resource schema data.
+type qosDiskResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Name             types.String `tfsdk:"name"`
+	Description      types.String `tfsdk:"description"`
+	ReadBytesSec     types.Int64  `tfsdk:"read_bytes_sec"`
+	WriteBytesSec    types.Int64  `tfsdk:"write_bytes_sec"`
+	ReadIOPSSec      types.Int64  `tfsdk:"read_iops_sec"`
+	WriteIOPSSec     types.Int64  `tfsdk:"write_iops_sec"`
+	ReadBytesSecMax  types.Int64  `tfsdk:"read_bytes_sec_max"`
+	WriteBytesSecMax types.Int64  `tfsdk:"write_bytes_sec_max"`
+	ReadIOPSSecMax   types.Int64  `tfsdk:"read_iops_sec_max"`
+	WriteIOPSSecMax  types.Int64  `tfsdk:"write_iops_sec_max"`
+}
+
+// iotuneValues devuelve punteros a los límites indexados por su nombre en iotune
+func (m *qosDiskResourceModel) iotuneValues() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"read_bytes_sec":      &m.ReadBytesSec,
+		"write_bytes_sec":     &m.WriteBytesSec,
+		"read_iops_sec":       &m.ReadIOPSSec,
+		"write_iops_sec":      &m.WriteIOPSSec,
+		"read_bytes_sec_max":  &m.ReadBytesSecMax,
+		"write_bytes_sec_max": &m.WriteBytesSecMax,
+		"read_iops_sec_max":   &m.ReadIOPSSecMax,
+		"write_iops_sec_max":  &m.WriteIOPSSecMax,
+	}
+}
+
+// iotuneToAPI construye el objeto iotune de la API con los límites configurados
+func (m *qosDiskResourceModel) iotuneToAPI() map[string]interface{} {
+	iotune := make(map[string]interface{})
+	for field, value := range m.iotuneValues() {
+		if !value.IsNull() && !value.IsUnknown() {
+			iotune[field] = value.ValueInt64()
+		}
+	}
+	return iotune
+}
+
+// Metadata returns the resource type name.
+func (r *qosDiskResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_qos_disk"
+}
+
+// Schema defines the schema for the resource.
+func (r *qosDiskResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	limit := func(description string) schema.Int64Attribute {
+		return schema.Int64Attribute{
+			Description: description,
+			Optional:    true,
+			Validators: []validator.Int64{
+				int64validator.AtLeast(1),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un perfil de QoS de disco (límites de E/S) en Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID único del QoS de disco. Si se omite, se deriva del nombre al crearlo.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(adminTableIDRegexp, "solo puede contener letras, números, '.', '_' y '-'"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Nombre del perfil de QoS.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Descripción del perfil de QoS.",
+				Optional:    true,
+			},
+			"read_bytes_sec":      limit("Velocidad de lectura sostenida en bytes/s."),
+			"write_bytes_sec":     limit("Velocidad de escritura sostenida en bytes/s."),
+			"read_iops_sec":       limit("Operaciones de lectura por segundo sostenidas."),
+			"write_iops_sec":      limit("Operaciones de escritura por segundo sostenidas."),
+			"read_bytes_sec_max":  limit("Velocidad de lectura pico en bytes/s. Requiere read_bytes_sec."),
+			"write_bytes_sec_max": limit("Velocidad de escritura pico en bytes/s. Requiere write_bytes_sec."),
+			"read_iops_sec_max":   limit("Operaciones de lectura por segundo pico. Requiere read_iops_sec."),
+			"write_iops_sec_max":  limit("Operaciones de escritura por segundo pico. Requiere write_iops_sec."),
+		},
+	}
+}
+
+// ValidateConfig comprueba que cada pico tenga su límite base y no sea menor que él.
+func (r *qosDiskResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config qosDiskResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := config.iotuneValues()
+	for _, field := range qosDiskIOTuneFields {
+		base, peak := values[field], values[field+"_max"]
+		if peak.IsNull() || peak.IsUnknown() || base.IsUnknown() {
+			continue
+		}
+
+		if base.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(field+"_max"),
+				"Falta el límite base",
+				fmt.Sprintf("%s_max requiere indicar también %s.", field, field),
+			)
+			continue
+		}
+		if peak.ValueInt64() < base.ValueInt64() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(field+"_max"),
+				"Límite incoherente",
+				fmt.Sprintf("%s_max (%d) no puede ser menor que %s (%d).", field, peak.ValueInt64(), field, base.ValueInt64()),
+			)
+		}
+	}
+}
+
+// ModifyPlan deriva el ID del nombre al crear el perfil sin id.
+func (r *qosDiskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nada que calcular al destruir el recurso
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan qosDiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || !plan.ID.IsUnknown() || plan.Name.IsUnknown() {
+		return
+	}
+
+	id := idFromName(plan.Name.ValueString())
+	if id == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"No se pudo derivar el ID",
+			"El nombre \""+plan.Name.ValueString()+"\" no contiene letras ni números; indica el atributo id.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *qosDiskResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *qosDiskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan qosDiskResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qosID, err := r.client.CreateQoSDisk(
+		plan.ID.ValueString(),
+		plan.Name.ValueString(),
+		plan.Description.ValueString(),
+		plan.iotuneToAPI(),
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando QoS de disco",
+			"No se pudo crear el QoS de disco: "+err.Error(),
+		)
+		return
+	}
+
+	// El ID planificado debe coincidir con el que guarda el servidor
+	if plannedID := plan.ID.ValueString(); qosID != plannedID {
+		plan.ID = types.StringValue(qosID)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError(
+			"ID de QoS de disco inesperado",
+			"Se solicitó el ID "+plannedID+" pero el servidor asignó "+qosID+". Indica id = \""+qosID+"\" o importa el recurso.",
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *qosDiskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state qosDiskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qos, err := r.client.GetQoSDisk(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "qos_disk not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo QoS de disco",
+			"No se pudo leer el QoS de disco ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(qos.Name)
+	if qos.Description != "" {
+		state.Description = types.StringValue(qos.Description)
+	}
+
+	// Los límites que faltan en la API se dejan a null para detectar cambios externos
+	for field, value := range state.iotuneValues() {
+		*value = iotuneFromAPI(qos.IOTune, field)
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *qosDiskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state qosDiskResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var name, description *string
+	if !plan.Name.Equal(state.Name) {
+		n := plan.Name.ValueString()
+		name = &n
+	}
+	if !plan.Description.Equal(state.Description) {
+		d := plan.Description.ValueString()
+		description = &d
+	}
+
+	// iotune se envía completo si cambia algún límite
+	var iotune map[string]interface{}
+	stateValues := state.iotuneValues()
+	for field, value := range plan.iotuneValues() {
+		if !value.Equal(*stateValues[field]) {
+			iotune = plan.iotuneToAPI()
+			break
+		}
+	}
+
+	err := r.client.UpdateQoSDisk(plan.ID.ValueString(), name, description, iotune)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando QoS de disco",
+			"No se pudo actualizar el QoS de disco ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *qosDiskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state qosDiskResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteQoSDisk(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando QoS de disco",
+			"No se pudo eliminar el QoS de disco ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState importa un QoS de disco por su ID o, si no existe ninguno con ese ID, por su nombre exacto.
+func (r *qosDiskResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	qosID := req.ID
+
+	if _, err := r.client.GetQoSDisk(req.ID); err != nil {
+		if err.Error() != "qos_disk not found" {
+			resp.Diagnostics.AddError(
+				"Error importando QoS de disco",
+				"No se pudo leer el QoS de disco "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		qos, err := r.client.FindQoSDiskByName(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importando QoS de disco",
+				"No existe ningún QoS de disco con ID o nombre "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+		qosID = qos.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), qosID)...)
+}
+
+// iotuneFromAPI extrae un límite numérico del mapa iotune de la API (null si no existe o es 0)
+func iotuneFromAPI(iotune map[string]interface{}, key string) types.Int64 {
+	var value int64
+	switch val := iotune[key].(type) {
+	case float64:
+		value = int64(val)
+	case int:
+		value = int64(val)
+	case int64:
+		value = val
+	case string:
+		value, _ = strconv.ParseInt(val, 10, 64)
+	}
+
+	// En libvirt 0 equivale a sin límite
+	if value <= 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(value)
+}
+
+// qosDiskIDAttributeSchema devuelve el esquema del atributo qos_disk_id del hardware de desktops y deployments
+func qosDiskIDAttributeSchema(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		MarkdownDescription: description,
+	}
+}
+
+// qosDiskIDUpdate devuelve el perfil de QoS de disco a enviar en un update: el configurado,
+// "unlimited" si se deja de gestionar o "" si no hay cambios que enviar
+func qosDiskIDUpdate(plan, state types.String) string {
+	if plan.Equal(state) {
+		return ""
+	}
+	if !plan.IsNull() {
+		return plan.ValueString()
+	}
+	if !state.IsNull() {
+		return client.QoSDiskUnlimited
+	}
+	return ""
+}
@@ -4,18 +4,24 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tknika/terraform-provider-isard/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &qosNetResource{}
-	_ resource.ResourceWithConfigure = &qosNetResource{}
+	_ resource.Resource                   = &qosNetResource{}
+	_ resource.ResourceWithConfigure      = &qosNetResource{}
+	_ resource.ResourceWithValidateConfig = &qosNetResource{}
+	_ resource.ResourceWithModifyPlan     = &qosNetResource{}
+	_ resource.ResourceWithImportState    = &qosNetResource{}
 )
 
 // NewQoSNetResource is a helper function to simplify the provider implementation.
@@ -30,15 +36,68 @@ type qosNetResource struct {
 
 // qosNetResourceModel maps the resource schema data.
 type qosNetResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	AverageDownload  types.Int64  `tfsdk:"average_download"`
-	AverageUpload    types.Int64  `tfsdk:"average_upload"`
-	PeakDownload     types.Int64  `tfsdk:"peak_download"`
-	PeakUpload       types.Int64  `tfsdk:"peak_upload"`
-	BurstDownload    types.Int64  `tfsdk:"burst_download"`
-	BurstUpload      types.Int64  `tfsdk:"burst_upload"`
+	ID              types.String          `tfsdk:"id"`
+	Name            types.String          `tfsdk:"name"`
+	Description     types.String          `tfsdk:"description"`
+	AverageDownload types.Int64           `tfsdk:"average_download"`
+	AverageUpload   types.Int64           `tfsdk:"average_upload"`
+	PeakDownload    types.Int64           `tfsdk:"peak_download"`
+	PeakUpload      types.Int64           `tfsdk:"peak_upload"`
+	BurstDownload   types.Int64           `tfsdk:"burst_download"`
+	BurstUpload     types.Int64           `tfsdk:"burst_upload"`
+	Bandwidth       *qosNetBandwidthModel `tfsdk:"bandwidth"`
+}
+
+// bandwidthValues devuelve punteros a los valores numéricos indexados por nombre de campo
+func (m *qosNetResourceModel) bandwidthValues() map[string]*types.Int64 {
+	return map[string]*types.Int64{
+		"average_download": &m.AverageDownload,
+		"average_upload":   &m.AverageUpload,
+		"peak_download":    &m.PeakDownload,
+		"peak_upload":      &m.PeakUpload,
+		"burst_download":   &m.BurstDownload,
+		"burst_upload":     &m.BurstUpload,
+	}
+}
+
+// bandwidthMap devuelve una copia de los valores numéricos indexados por nombre de campo
+func (m *qosNetResourceModel) bandwidthMap() map[string]types.Int64 {
+	values := make(map[string]types.Int64)
+	for field, value := range m.bandwidthValues() {
+		values[field] = *value
+	}
+	return values
+}
+
+// effectiveBandwidth combina los valores numéricos con los escritos con unidades en bandwidth.
+// Devuelve también los errores de conversión indexados por campo.
+func (m *qosNetResourceModel) effectiveBandwidth() (map[string]types.Int64, map[string]error) {
+	values := make(map[string]types.Int64)
+	errs := make(map[string]error)
+	withUnits := m.Bandwidth.fields()
+
+	for field, value := range m.bandwidthValues() {
+		values[field] = *value
+		if !value.IsNull() {
+			continue
+		}
+
+		text, ok := withUnits[field]
+		switch {
+		case !ok || text.IsNull():
+		case text.IsUnknown():
+			values[field] = types.Int64Unknown()
+		default:
+			parsed, err := parseBandwidth(text.ValueString())
+			if err != nil {
+				errs[field] = err
+				continue
+			}
+			values[field] = types.Int64Value(parsed)
+		}
+	}
+
+	return values, errs
 }
 
 // Metadata returns the resource type name.
@@ -52,10 +111,15 @@ func (r *qosNetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 		Description: "Gestiona un perfil de QoS de red en Isard VDI.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "ID único del QoS de red.",
+				Description: "ID único del QoS de red. Si se omite, se deriva del nombre al crearlo (ej. 'QoS Aula 1' -> 'qos-aula-1').",
+				Optional:    true,
 				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(adminTableIDRegexp, "solo puede contener letras, números, '.', '_' y '-'"),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -67,33 +131,147 @@ func (r *qosNetResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Optional:    true,
 			},
 			"average_download": schema.Int64Attribute{
-				Description: "Velocidad media de descarga en KB/s.",
+				Description: "Velocidad media de descarga en KB/s. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"average_upload": schema.Int64Attribute{
-				Description: "Velocidad media de subida en KB/s.",
+				Description: "Velocidad media de subida en KB/s. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"peak_download": schema.Int64Attribute{
-				Description: "Velocidad pico de descarga en KB/s.",
+				Description: "Velocidad pico de descarga en KB/s. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"peak_upload": schema.Int64Attribute{
-				Description: "Velocidad pico de subida en KB/s.",
+				Description: "Velocidad pico de subida en KB/s. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"burst_download": schema.Int64Attribute{
-				Description: "Ráfaga de descarga en KB.",
+				Description: "Ráfaga de descarga en KB. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
 			"burst_upload": schema.Int64Attribute{
-				Description: "Ráfaga de subida en KB.",
+				Description: "Ráfaga de subida en KB. Se calcula desde bandwidth si se usa.",
 				Optional:    true,
+				Computed:    true,
 			},
+			"bandwidth": qosBandwidthAttributeSchema(),
 		},
 	}
 }
 
+// ValidateConfig convierte los valores con unidades y comprueba la coherencia
+// de media, pico y ráfaga en cada dirección.
+func (r *qosNetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config qosNetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	withUnits := config.Bandwidth.fields()
+	for field, value := range config.bandwidthValues() {
+		if text, ok := withUnits[field]; ok && !value.IsNull() && !text.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bandwidth").AtName(field),
+				"Atributos en conflicto",
+				fmt.Sprintf("%s se indica a la vez como número y en bandwidth; usa solo uno de los dos.", field),
+			)
+		}
+	}
+
+	values, errs := config.effectiveBandwidth()
+	for field, err := range errs {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bandwidth").AtName(field),
+			"Valor de ancho de banda no válido",
+			err.Error(),
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, direction := range []string{"download", "upload"} {
+		if field, err := validateBandwidth(values, direction); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(field),
+				"Ancho de banda incoherente",
+				err.Error(),
+			)
+		}
+	}
+}
+
+// ModifyPlan calcula los valores numéricos a partir de la configuración, incluidos
+// los indicados con unidades en bandwidth.
+func (r *qosNetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nada que calcular al destruir el recurso
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan qosNetResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, _ := config.effectiveBandwidth()
+	for field, value := range plan.bandwidthValues() {
+		*value = values[field]
+	}
+
+	// Al crear sin id, derivarlo del nombre para que sea conocido en el plan
+	if plan.ID.IsUnknown() && !plan.Name.IsUnknown() {
+		id := idFromName(plan.Name.ValueString())
+		if id == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"No se pudo derivar el ID",
+				"El nombre \""+plan.Name.ValueString()+"\" no contiene letras ni números; indica el atributo id.",
+			)
+			return
+		}
+		plan.ID = types.StringValue(id)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// ImportState importa un QoS de red por su ID o, si no existe ninguno con ese ID, por su nombre exacto.
+func (r *qosNetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	qosID := req.ID
+
+	if _, err := r.client.GetQoSNet(req.ID); err != nil {
+		if err.Error() != "qos_net not found" {
+			resp.Diagnostics.AddError(
+				"Error importando QoS de red",
+				"No se pudo leer el QoS de red "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+
+		qos, err := r.client.FindQoSNetByName(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error importando QoS de red",
+				"No existe ningún QoS de red con ID o nombre "+req.ID+": "+err.Error(),
+			)
+			return
+		}
+		qosID = qos.ID
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), qosID)...)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *qosNetResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
@@ -125,29 +303,11 @@ func (r *qosNetResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Construir el objeto bandwidth
-	bandwidth := make(map[string]interface{})
-	
-	if !plan.AverageDownload.IsNull() {
-		bandwidth["average_download"] = plan.AverageDownload.ValueInt64()
-	}
-	if !plan.AverageUpload.IsNull() {
-		bandwidth["average_upload"] = plan.AverageUpload.ValueInt64()
-	}
-	if !plan.PeakDownload.IsNull() {
-		bandwidth["peak_download"] = plan.PeakDownload.ValueInt64()
-	}
-	if !plan.PeakUpload.IsNull() {
-		bandwidth["peak_upload"] = plan.PeakUpload.ValueInt64()
-	}
-	if !plan.BurstDownload.IsNull() {
-		bandwidth["burst_download"] = plan.BurstDownload.ValueInt64()
-	}
-	if !plan.BurstUpload.IsNull() {
-		bandwidth["burst_upload"] = plan.BurstUpload.ValueInt64()
-	}
+	bandwidth := bandwidthToAPI(plan.bandwidthMap())
 
 	// Crear el QoS de red
 	qosID, err := r.client.CreateQoSNet(
+		plan.ID.ValueString(),
 		plan.Name.ValueString(),
 		plan.Description.ValueString(),
 		bandwidth,
@@ -160,8 +320,16 @@ func (r *qosNetResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	// Establecer el ID
-	plan.ID = types.StringValue(qosID)
+	// El ID planificado debe coincidir con el que guarda el servidor
+	if plannedID := plan.ID.ValueString(); qosID != plannedID {
+		plan.ID = types.StringValue(qosID)
+		resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+		resp.Diagnostics.AddError(
+			"ID de QoS de red inesperado",
+			"Se solicitó el ID "+plannedID+" pero el servidor asignó "+qosID+". Indica id = \""+qosID+"\" o importa el recurso.",
+		)
+		return
+	}
 
 	// Set state to fully populated data
 	diags = resp.State.Set(ctx, plan)
@@ -201,26 +369,9 @@ func (r *qosNetResource) Read(ctx context.Context, req resource.ReadRequest, res
 		state.Description = types.StringValue(qos.Description)
 	}
 
-	// Extraer valores de bandwidth si existen
-	if qos.Bandwidth != nil {
-		if val, ok := qos.Bandwidth["average_download"].(float64); ok {
-			state.AverageDownload = types.Int64Value(int64(val))
-		}
-		if val, ok := qos.Bandwidth["average_upload"].(float64); ok {
-			state.AverageUpload = types.Int64Value(int64(val))
-		}
-		if val, ok := qos.Bandwidth["peak_download"].(float64); ok {
-			state.PeakDownload = types.Int64Value(int64(val))
-		}
-		if val, ok := qos.Bandwidth["peak_upload"].(float64); ok {
-			state.PeakUpload = types.Int64Value(int64(val))
-		}
-		if val, ok := qos.Bandwidth["burst_download"].(float64); ok {
-			state.BurstDownload = types.Int64Value(int64(val))
-		}
-		if val, ok := qos.Bandwidth["burst_upload"].(float64); ok {
-			state.BurstUpload = types.Int64Value(int64(val))
-		}
+	// Los valores que faltan en la API se dejan a null para detectar cambios externos
+	for field, value := range state.bandwidthValues() {
+		*value = bandwidthFromAPI(qos.Bandwidth, field)
 	}
 
 	// Set refreshed state
@@ -270,26 +421,7 @@ func (r *qosNetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		!plan.PeakUpload.Equal(state.PeakUpload) ||
 		!plan.BurstDownload.Equal(state.BurstDownload) ||
 		!plan.BurstUpload.Equal(state.BurstUpload) {
-		
-		bandwidth = make(map[string]interface{})
-		if !plan.AverageDownload.IsNull() {
-			bandwidth["average_download"] = plan.AverageDownload.ValueInt64()
-		}
-		if !plan.AverageUpload.IsNull() {
-			bandwidth["average_upload"] = plan.AverageUpload.ValueInt64()
-		}
-		if !plan.PeakDownload.IsNull() {
-			bandwidth["peak_download"] = plan.PeakDownload.ValueInt64()
-		}
-		if !plan.PeakUpload.IsNull() {
-			bandwidth["peak_upload"] = plan.PeakUpload.ValueInt64()
-		}
-		if !plan.BurstDownload.IsNull() {
-			bandwidth["burst_download"] = plan.BurstDownload.ValueInt64()
-		}
-		if !plan.BurstUpload.IsNull() {
-			bandwidth["burst_upload"] = plan.BurstUpload.ValueInt64()
-		}
+		bandwidth = bandwidthToAPI(plan.bandwidthMap())
 	}
 
 	// Actualizar el QoS de red
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &quotaResource{}
+	_ resource.ResourceWithConfigure      = &quotaResource{}
+	_ resource.ResourceWithValidateConfig = &quotaResource{}
+)
+
+// NewQuotaResource is a helper function to simplify the provider implementation.
+func NewQuotaResource() resource.Resource {
+	return &quotaResource{}
+}
+
+// quotaResource is the resource implementation.
+type quotaResource struct {
+	client *client.Client
+}
+
+// quotaResourceModel maps the resource schema data.
+type quotaResourceModel struct {
+	ID       types.String `tfsdk:"id"`
+	Kind     types.String `tfsdk:"kind"`
+	TargetID types.String `tfsdk:"target_id"`
+	Quota    *quotaModel  `tfsdk:"quota"`
+	Limits   *quotaModel  `tfsdk:"limits"`
+}
+
+// quotaKinds son los tipos de objeto a los que se puede aplicar una cuota
+var quotaKinds = []string{"user", "group", "category"}
+
+// Metadata returns the resource type name.
+func (r *quotaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quota"
+}
+
+// Schema defines the schema for the resource.
+func (r *quotaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona la cuota y los límites de un usuario, grupo o categoría en Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador con formato `<kind>/<target_id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Tipo de objeto al que se aplica la cuota (user, group, category)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(quotaKinds...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID del usuario, grupo o categoría",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"quota":  quotaAttributeSchema("Cuota por usuario. Si se omite, se hereda del nivel superior."),
+			"limits": quotaAttributeSchema("Límites totales del grupo o categoría. No aplica a usuarios."),
+		},
+	}
+}
+
+// ValidateConfig comprueba que limits solo se use con grupos y categorías.
+func (r *quotaResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config quotaResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Kind.ValueString() == "user" && config.Limits != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("limits"),
+			"Atributo no soportado",
+			"Los usuarios no tienen límites propios; usa limits solo con kind = \"group\" o \"category\".",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *quotaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *quotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan quotaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setQuota(plan.Kind.ValueString(), plan.TargetID.ValueString(), quotaToAPI(plan.Quota), quotaToAPI(plan.Limits))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error aplicando cuota",
+			"No se pudo aplicar la cuota a "+plan.Kind.ValueString()+" "+plan.TargetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(plan.Kind.ValueString() + "/" + plan.TargetID.ValueString())
+
+	// Leer la cuota aplicada para obtener los valores computados
+	quota, limits, err := r.getQuota(plan.Kind.ValueString(), plan.TargetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo cuota",
+			"No se pudo leer la cuota aplicada: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Quota = quotaFromAPI(quota)
+	plan.Limits = quotaFromAPI(limits)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *quotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state quotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	quota, limits, err := r.getQuota(state.Kind.ValueString(), state.TargetID.ValueString())
+	if err != nil {
+		if err.Error() == state.Kind.ValueString()+" not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo cuota",
+			"No se pudo leer la cuota de "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Quota = quotaFromAPI(quota)
+	state.Limits = quotaFromAPI(limits)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *quotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan quotaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setQuota(plan.Kind.ValueString(), plan.TargetID.ValueString(), quotaToAPI(plan.Quota), quotaToAPI(plan.Limits))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando cuota",
+			"No se pudo actualizar la cuota de "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Leer la cuota aplicada para obtener los valores computados
+	quota, limits, err := r.getQuota(plan.Kind.ValueString(), plan.TargetID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo cuota",
+			"No se pudo leer la cuota actualizada: "+err.Error(),
+		)
+		return
+	}
+
+	plan.Quota = quotaFromAPI(quota)
+	plan.Limits = quotaFromAPI(limits)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *quotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state quotaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Si el objeto ya no existe no hay nada que restablecer
+	if _, _, err := r.getQuota(state.Kind.ValueString(), state.TargetID.ValueString()); err != nil {
+		if err.Error() == state.Kind.ValueString()+" not found" {
+			return
+		}
+	}
+
+	// Eliminar el recurso devuelve el objeto a la cuota heredada (false)
+	err := r.setQuota(state.Kind.ValueString(), state.TargetID.ValueString(), false, false)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando cuota",
+			"No se pudo restablecer la cuota de "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// getQuota obtiene la cuota y los límites propios del objeto indicado
+func (r *quotaResource) getQuota(kind, targetID string) (interface{}, interface{}, error) {
+	switch kind {
+	case "user":
+		user, err := r.client.GetUser(targetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return user.Quota, false, nil
+	case "group":
+		group, err := r.client.GetGroup(targetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return group.Quota, group.Limits, nil
+	case "category":
+		category, err := r.client.GetCategory(targetID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return category.Quota, category.Limits, nil
+	}
+	return nil, nil, fmt.Errorf("tipo de objeto no soportado: %s", kind)
+}
+
+// setQuota aplica la cuota y los límites al objeto indicado
+func (r *quotaResource) setQuota(kind, targetID string, quota, limits interface{}) error {
+	switch kind {
+	case "user":
+		return r.client.UpdateUser(targetID, map[string]interface{}{"quota": quota})
+	case "group":
+		return r.client.UpdateGroup(targetID, map[string]interface{}{"quota": quota, "limits": limits})
+	case "category":
+		return r.client.UpdateCategory(targetID, map[string]interface{}{"quota": quota, "limits": limits})
+	}
+	return fmt.Errorf("tipo de objeto no soportado: %s", kind)
+}
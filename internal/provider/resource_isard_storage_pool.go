@@ -0,0 +1,438 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &storagePoolResource{}
+	_ resource.ResourceWithConfigure      = &storagePoolResource{}
+	_ resource.ResourceWithValidateConfig = &storagePoolResource{}
+	_ resource.ResourceWithImportState    = &storagePoolResource{}
+)
+
+// storagePoolMountpointRegexp exige que el punto de montaje sea una ruta absoluta
+var storagePoolMountpointRegexp = regexp.MustCompile(`^/`)
+
+// NewStoragePoolResource is a helper function to simplify the provider implementation.
+func NewStoragePoolResource() resource.Resource {
+	return &storagePoolResource{}
+}
+
+// storagePoolResource is the resource implementation.
+type storagePoolResource struct {
+	client *client.Client
+}
+
+// storagePoolResourceModel maps the resource schema data.
+type storagePoolResourceModel struct {
+	ID          types.String           `tfsdk:"id"`
+	Name        types.String           `tfsdk:"name"`
+	Description types.String           `tfsdk:"description"`
+	Mountpoint  types.String           `tfsdk:"mountpoint"`
+	Paths       *storagePoolPathsModel `tfsdk:"paths"`
+	Categories  types.List             `tfsdk:"categories"`
+	Enabled     types.Bool             `tfsdk:"enabled"`
+	ReadOnly    types.Bool             `tfsdk:"read_only"`
+}
+
+// storagePoolPathsModel agrupa las rutas del storage pool por tipo de disco
+type storagePoolPathsModel struct {
+	Desktop  []storagePoolPathModel `tfsdk:"desktop"`
+	Template []storagePoolPathModel `tfsdk:"template"`
+	Media    []storagePoolPathModel `tfsdk:"media"`
+	Volatile []storagePoolPathModel `tfsdk:"volatile"`
+}
+
+// storagePoolPathModel es una ruta relativa al punto de montaje con su peso
+type storagePoolPathModel struct {
+	Path   types.String `tfsdk:"path"`
+	Weight types.Int64  `tfsdk:"weight"`
+}
+
+// byKind devuelve punteros a las rutas indexadas por tipo (ver client.StoragePoolPathKinds)
+func (m *storagePoolPathsModel) byKind() map[string]*[]storagePoolPathModel {
+	return map[string]*[]storagePoolPathModel{
+		"desktop":  &m.Desktop,
+		"template": &m.Template,
+		"media":    &m.Media,
+		"volatile": &m.Volatile,
+	}
+}
+
+// toClient convierte el modelo a la estructura del cliente
+func (m *storagePoolResourceModel) toClient(ctx context.Context) (*client.StoragePool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	pool := &client.StoragePool{
+		Name:        m.Name.ValueString(),
+		Description: m.Description.ValueString(),
+		Mountpoint:  m.Mountpoint.ValueString(),
+		Paths:       make(map[string][]client.StoragePoolPath, len(client.StoragePoolPathKinds)),
+		Categories:  []string{},
+		Enabled:     m.Enabled.ValueBool(),
+		ReadOnly:    m.ReadOnly.ValueBool(),
+	}
+
+	paths := m.Paths.byKind()
+	for _, kind := range client.StoragePoolPathKinds {
+		pool.Paths[kind] = []client.StoragePoolPath{}
+		for _, p := range *paths[kind] {
+			pool.Paths[kind] = append(pool.Paths[kind], client.StoragePoolPath{
+				Path:   p.Path.ValueString(),
+				Weight: p.Weight.ValueInt64(),
+			})
+		}
+	}
+
+	if !m.Categories.IsNull() && !m.Categories.IsUnknown() {
+		diags.Append(m.Categories.ElementsAs(ctx, &pool.Categories, false)...)
+	}
+
+	return pool, diags
+}
+
+// refresh actualiza el modelo con los valores del storage pool en la API.
+// Los tipos de ruta y las categorías vacíos solo se guardan si ya se gestionaban.
+func (m *storagePoolResourceModel) refresh(ctx context.Context, pool *client.StoragePool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.Name = types.StringValue(pool.Name)
+	if pool.Description != "" || !m.Description.IsNull() {
+		m.Description = types.StringValue(pool.Description)
+	}
+	m.Mountpoint = types.StringValue(pool.Mountpoint)
+	m.Enabled = types.BoolValue(pool.Enabled)
+	m.ReadOnly = types.BoolValue(pool.ReadOnly)
+
+	if m.Paths == nil {
+		m.Paths = &storagePoolPathsModel{}
+	}
+	for kind, paths := range m.Paths.byKind() {
+		if len(pool.Paths[kind]) == 0 && *paths == nil {
+			continue
+		}
+		*paths = []storagePoolPathModel{}
+		for _, p := range pool.Paths[kind] {
+			*paths = append(*paths, storagePoolPathModel{
+				Path:   types.StringValue(p.Path),
+				Weight: types.Int64Value(p.Weight),
+			})
+		}
+	}
+
+	if len(pool.Categories) > 0 || !m.Categories.IsNull() {
+		categories, d := types.ListValueFrom(ctx, types.StringType, pool.Categories)
+		diags.Append(d...)
+		m.Categories = categories
+	}
+
+	return diags
+}
+
+// Metadata returns the resource type name.
+func (r *storagePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_pool"
+}
+
+// Schema defines the schema for the resource.
+func (r *storagePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	pathList := func(description string) schema.ListNestedAttribute {
+		return schema.ListNestedAttribute{
+			Description: description,
+			Optional:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"path": schema.StringAttribute{
+						Description: "Ruta relativa al punto de montaje.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+					"weight": schema.Int64Attribute{
+						Description: "Peso de la ruta al repartir los discos entre las rutas del mismo tipo (1-100, por defecto: 100).",
+						Optional:    true,
+						Computed:    true,
+						Default:     int64default.StaticInt64(100),
+						Validators: []validator.Int64{
+							int64validator.Between(1, 100),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un storage pool de Isard VDI: dónde se guardan los discos de los desktops, plantillas, medios y desktops volátiles (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "ID único del storage pool.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Nombre del storage pool.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Descripción del storage pool.",
+				Optional:    true,
+			},
+			"mountpoint": schema.StringAttribute{
+				Description: "Punto de montaje del almacenamiento en los hipervisores (por ejemplo, /isard o un montaje NFS).",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(storagePoolMountpointRegexp, "debe ser una ruta absoluta"),
+				},
+			},
+			"paths": schema.SingleNestedAttribute{
+				Description: "Rutas del storage pool por tipo de disco.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"desktop":  pathList("Rutas de los discos de los desktops."),
+					"template": pathList("Rutas de los discos de las plantillas."),
+					"media":    pathList("Rutas de los medios (ISOs y disquetes)."),
+					"volatile": pathList("Rutas de los discos de los desktops volátiles."),
+				},
+			},
+			"categories": schema.ListAttribute{
+				Description: "IDs de las categorías cuyos discos se guardan en este storage pool. Si se omite, el pool no se asigna a ninguna categoría.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Si el storage pool está habilitado (por defecto: true).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"read_only": schema.BoolAttribute{
+				Description: "Si el storage pool es de solo lectura: sus discos se pueden usar, pero no se crean discos nuevos en él (por defecto: false).",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+// ValidateConfig comprueba que haya al menos una ruta y que las rutas sean relativas y no se repitan.
+func (r *storagePoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config storagePoolResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Paths == nil {
+		return
+	}
+
+	total := 0
+	known := true
+	for kind, paths := range config.Paths.byKind() {
+		seen := make(map[string]bool, len(*paths))
+		for i, p := range *paths {
+			total++
+			if p.Path.IsUnknown() {
+				known = false
+				continue
+			}
+			if p.Path.IsNull() {
+				continue
+			}
+
+			attrPath := path.Root("paths").AtName(kind).AtListIndex(i).AtName("path")
+			value := p.Path.ValueString()
+			if strings.HasPrefix(value, "/") || containsParentDir(value) {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"Ruta no válida",
+					fmt.Sprintf("La ruta %q debe ser relativa al punto de montaje y no puede contener \"..\".", value),
+				)
+				continue
+			}
+			if seen[value] {
+				resp.Diagnostics.AddAttributeError(
+					attrPath,
+					"Ruta duplicada",
+					fmt.Sprintf("La ruta %q aparece varias veces en paths.%s.", value, kind),
+				)
+			}
+			seen[value] = true
+		}
+	}
+
+	if total == 0 && known {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("paths"),
+			"Faltan rutas",
+			"Indica al menos una ruta en paths (desktop, template, media o volatile).",
+		)
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *storagePoolResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *storagePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan storagePoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, diags := plan.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID, err := r.client.CreateStoragePool(pool)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando storage pool",
+			"No se pudo crear el storage pool: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(poolID)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *storagePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state storagePoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, err := r.client.GetStoragePool(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "storage_pool not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo storage pool",
+			"No se pudo leer el storage pool ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.refresh(ctx, pool)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *storagePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan storagePoolResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool, diags := plan.toClient(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateStoragePool(plan.ID.ValueString(), pool)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando storage pool",
+			"No se pudo actualizar el storage pool ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *storagePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state storagePoolResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStoragePool(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando storage pool",
+			"No se pudo eliminar el storage pool ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState importa un storage pool por su ID.
+func (r *storagePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
+
+// containsParentDir indica si una ruta contiene el componente ".."
+func containsParentDir(value string) bool {
+	for _, part := range strings.Split(value, "/") {
+		if part == ".." {
+			return true
+		}
+	}
+	return false
+}
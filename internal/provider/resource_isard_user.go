@@ -0,0 +1,380 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &userResource{}
+	_ resource.ResourceWithConfigure = &userResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &userResource{}
+}
+
+// userResource is the resource implementation.
+type userResource struct {
+	client *client.Client
+}
+
+// userResourceModel maps the resource schema data.
+type userResourceModel struct {
+	ID              types.String `tfsdk:"id"`
+	Provider        types.String `tfsdk:"auth_provider"`
+	Username        types.String `tfsdk:"username"`
+	Name            types.String `tfsdk:"name"`
+	Email           types.String `tfsdk:"email"`
+	Role            types.String `tfsdk:"role"`
+	Category        types.String `tfsdk:"category"`
+	Group           types.String `tfsdk:"group"`
+	SecondaryGroups types.List   `tfsdk:"secondary_groups"`
+	Active          types.Bool   `tfsdk:"active"`
+	Quota           *quotaModel  `tfsdk:"quota"`
+	Password        types.String `tfsdk:"password"`
+	PasswordVersion types.Int64  `tfsdk:"password_version"`
+}
+
+// userRoles son los roles de usuario que acepta Isard
+var userRoles = []string{"admin", "manager", "advanced", "user"}
+
+// Metadata returns the resource type name.
+func (r *userResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un usuario en Isard VDI (solo administradores).",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador único del usuario",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"auth_provider": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("local"),
+				MarkdownDescription: "Proveedor de autenticación del usuario (por defecto: local)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Nombre de usuario para iniciar sesión",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Nombre completo del usuario",
+			},
+			"email": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Correo electrónico del usuario. Si se quita de la configuración, se conserva el valor actual",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"role": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Rol del usuario (admin, manager, advanced, user)",
+				Validators: []validator.String{
+					stringvalidator.OneOf(userRoles...),
+				},
+			},
+			"category": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID de la categoría del usuario",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID del grupo principal del usuario",
+			},
+			"secondary_groups": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Lista de IDs de grupos secundarios",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Si el usuario está habilitado (por defecto: true)",
+			},
+			"quota": quotaAttributeSchema("Cuota propia del usuario. Si se omite, se hereda la del grupo."),
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				MarkdownDescription: "Contraseña del usuario (write-only, no se guarda en el estado)",
+			},
+			"password_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Versión de la contraseña. Cámbiala para volver a enviar `password` en un update",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *userResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// La contraseña es write-only: solo está disponible en la configuración
+	var password types.String
+	diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secondaryGroups := []string{}
+	if !plan.SecondaryGroups.IsNull() && !plan.SecondaryGroups.IsUnknown() {
+		diags = plan.SecondaryGroups.ElementsAs(ctx, &secondaryGroups, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	user := &client.User{
+		Provider:        plan.Provider.ValueString(),
+		Username:        plan.Username.ValueString(),
+		Name:            plan.Name.ValueString(),
+		Email:           plan.Email.ValueString(),
+		Role:            plan.Role.ValueString(),
+		Category:        plan.Category.ValueString(),
+		Group:           plan.Group.ValueString(),
+		SecondaryGroups: secondaryGroups,
+		Active:          plan.Active.ValueBool(),
+		Quota:           quotaToAPI(plan.Quota),
+	}
+
+	userID, err := r.client.CreateUser(user, password.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando usuario",
+			"No se pudo crear el usuario: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(userID)
+
+	// Leer el usuario creado para obtener los valores computados
+	created, err := r.client.GetUser(userID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo usuario creado",
+			"No se pudo leer el usuario recién creado: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(created, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetUser(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "user not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo usuario",
+			"No se pudo leer el usuario ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	state.Username = types.StringValue(user.Username)
+	state.Name = types.StringValue(user.Name)
+	state.Role = types.StringValue(user.Role)
+	state.Category = types.StringValue(user.Category)
+	state.Group = types.StringValue(user.Group)
+	state.Active = types.BoolValue(user.Active)
+	if user.Provider != "" {
+		state.Provider = types.StringValue(user.Provider)
+	}
+
+	// secondary_groups: mantener null si no se gestiona y la API no devuelve ninguno
+	if len(user.SecondaryGroups) > 0 || !state.SecondaryGroups.IsNull() {
+		secondaryGroups, diags := types.ListValueFrom(ctx, types.StringType, user.SecondaryGroups)
+		resp.Diagnostics.Append(diags...)
+		state.SecondaryGroups = secondaryGroups
+	}
+
+	r.mapComputed(user, &state)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan userResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secondaryGroups := []string{}
+	if !plan.SecondaryGroups.IsNull() && !plan.SecondaryGroups.IsUnknown() {
+		diags = plan.SecondaryGroups.ElementsAs(ctx, &secondaryGroups, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updateData := map[string]interface{}{
+		"name":             plan.Name.ValueString(),
+		"role":             plan.Role.ValueString(),
+		"group":            plan.Group.ValueString(),
+		"secondary_groups": secondaryGroups,
+		"active":           plan.Active.ValueBool(),
+	}
+	setQuotaUpdate(updateData, "quota", plan.Quota, state.Quota)
+	// email es computado: si no se configura, el plan conserva el valor del
+	// estado y solo es desconocido si nunca se ha leído de la API
+	if !plan.Email.IsUnknown() {
+		updateData["email"] = plan.Email.ValueString()
+	}
+
+	// Solo se reenvía la contraseña cuando cambia su versión
+	if !plan.PasswordVersion.Equal(state.PasswordVersion) {
+		var password types.String
+		diags = req.Config.GetAttribute(ctx, path.Root("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !password.IsNull() && password.ValueString() != "" {
+			updateData["password"] = password.ValueString()
+		}
+	}
+
+	err := r.client.UpdateUser(plan.ID.ValueString(), updateData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando usuario",
+			"No se pudo actualizar el usuario ID "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Leer el usuario actualizado para obtener los valores computados
+	updated, err := r.client.GetUser(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo usuario actualizado",
+			"No se pudo leer el usuario actualizado: "+err.Error(),
+		)
+		return
+	}
+
+	r.mapComputed(updated, &plan)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state userResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando usuario",
+			"No se pudo eliminar el usuario ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// mapComputed copia al modelo los valores computados (email y cuota) devueltos por la API
+func (r *userResource) mapComputed(user *client.User, model *userResourceModel) {
+	model.Email = types.StringValue(user.Email)
+
+	// La cuota solo se refleja si se gestiona desde Terraform
+	if model.Quota != nil {
+		model.Quota = quotaFromAPI(user.Quota)
+	}
+}
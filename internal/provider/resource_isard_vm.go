@@ -4,20 +4,37 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/tknika/terraform-provider-isard/internal/client"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &vmResource{}
-	_ resource.ResourceWithConfigure = &vmResource{}
+	_ resource.Resource                     = &vmResource{}
+	_ resource.ResourceWithConfigure        = &vmResource{}
+	_ resource.ResourceWithConfigValidators = &vmResource{}
 )
 
+// bootDevices son los dispositivos de arranque que acepta Isard
+var bootDevices = []string{"disk", "iso", "floppy", "pxe"}
+
+// diskBuses son los buses de disco que acepta Isard
+var diskBuses = []string{"default", "virtio", "ide", "sata"}
+
 // NewVMResource is a helper function to simplify the provider implementation.
 func NewVMResource() resource.Resource {
 	return &vmResource{}
@@ -30,13 +47,35 @@ type vmResource struct {
 
 // vmResourceModel maps the resource schema data.
 type vmResourceModel struct {
-	ID          types.String  `tfsdk:"id"`
-	Name        types.String  `tfsdk:"name"`
-	Description types.String  `tfsdk:"description"`
-	TemplateID  types.String  `tfsdk:"template_id"`
-	VCPUs       types.Int64   `tfsdk:"vcpus"`
-	Memory      types.Float64 `tfsdk:"memory"`
-	Interfaces  types.List    `tfsdk:"interfaces"`
+	ID          types.String      `tfsdk:"id"`
+	Name        types.String      `tfsdk:"name"`
+	Description types.String      `tfsdk:"description"`
+	TemplateID  types.String      `tfsdk:"template_id"`
+	MediaID     types.String      `tfsdk:"media_id"`
+	DiskSize    types.Int64       `tfsdk:"disk_size"`
+	OSProfile   types.String      `tfsdk:"os_profile"`
+	VCPUs       types.Int64       `tfsdk:"vcpus"`
+	Memory      types.Float64     `tfsdk:"memory"`
+	Interfaces  types.List        `tfsdk:"interfaces"`
+	Isos        types.List        `tfsdk:"isos"`
+	Floppies    types.List        `tfsdk:"floppies"`
+	BootOrder   types.List        `tfsdk:"boot_order"`
+	DiskBus     types.String      `tfsdk:"disk_bus"`
+	Videos      types.List        `tfsdk:"videos"`
+	Graphics    types.List        `tfsdk:"graphics"`
+	Reservables *reservablesModel `tfsdk:"reservables"`
+	QoSDiskID   types.String      `tfsdk:"qos_disk_id"`
+	DirectLink  *directLinkModel  `tfsdk:"direct_link"`
+	Viewers     []viewerModel     `tfsdk:"viewer"`
+	Fullscreen  types.Bool        `tfsdk:"fullscreen"`
+	Credentials *credentialsModel `tfsdk:"credentials"`
+}
+
+// directLinkModel representa el enlace directo (jumper URL) del desktop.
+// Un bloque omitido (nil) tiene el enlace deshabilitado.
+type directLinkModel struct {
+	RotateTrigger types.String `tfsdk:"rotate_trigger"`
+	URL           types.String `tfsdk:"url"`
 }
 
 // Metadata returns the resource type name.
@@ -66,25 +105,149 @@ func (r *vmResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 				MarkdownDescription: "Descripción del desktop (máximo 255 caracteres)",
 			},
 			"template_id": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "ID de la plantilla a utilizar para crear el desktop",
+				Optional:            true,
+				MarkdownDescription: "ID de la plantilla a utilizar para crear el desktop. Incompatible con `media_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID del medio de instalación desde el que crear un desktop con disco vacío. Incompatible con `template_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"disk_size": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Tamaño en GB del disco vacío (requerido con `media_id`)",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"os_profile": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Perfil de instalación (virt-install) del sistema operativo (requerido con `media_id`)",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"vcpus": schema.Int64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "N\u00famero de CPUs virtuales (por defecto usa el del template)",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"memory": schema.Float64Attribute{
 				Optional:            true,
 				Computed:            true,
 				MarkdownDescription: "Memoria RAM en GB (por defecto usa la del template)",
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"interfaces": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
 				MarkdownDescription: "Lista de IDs de interfaces de red a utilizar (por defecto usa las del template)",
 			},
+			"isos": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Lista de IDs de medios ISO a adjuntar (ver `isard_media`)",
+			},
+			"floppies": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Lista de IDs de medios floppy a adjuntar (ver `isard_media`)",
+			},
+			"boot_order": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Orden de arranque (disk, iso, floppy, pxe). Por defecto usa el del template",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(bootDevices...)),
+				},
+			},
+			"disk_bus": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Bus del disco (default, virtio, ide, sata). Por defecto usa el del template",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(diskBuses...),
+				},
+			},
+			"videos": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Lista de IDs de tarjetas de vídeo. Por defecto usa las del template",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graphics": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Lista de IDs de dispositivos gráficos. Por defecto usa los del template",
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reservables": reservablesAttributeSchema("Perfil de vGPU reservable del desktop. Si se omite, usa el del template"),
+			"qos_disk_id": qosDiskIDAttributeSchema("ID del perfil de QoS de disco (límites de E/S) del desktop. Si se omite, usa el del template; quitarlo después de haberlo gestionado aplica `unlimited`"),
+			"direct_link": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Enlace directo al viewer del desktop, accesible sin iniciar sesión. Si se omite, el enlace está deshabilitado",
+				Attributes: map[string]schema.Attribute{
+					"rotate_trigger": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Valor arbitrario. Al cambiarlo se genera un nuevo enlace y el anterior deja de funcionar",
+					},
+					"url": schema.StringAttribute{
+						Computed:            true,
+						Sensitive:           true,
+						MarkdownDescription: "URL del enlace directo generada por Isard",
+						PlanModifiers: []planmodifier.String{
+							rotateTriggerPlanModifier{},
+						},
+					},
+				},
+			},
+			"fullscreen":  fullscreenAttributeSchema(),
+			"credentials": credentialsAttributeSchema(),
 		},
+		Blocks: map[string]schema.Block{
+			"viewer": viewerBlockSchema(),
+		},
+	}
+}
+
+// ConfigValidators exige un único origen para el desktop: template o medio.
+func (r *vmResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("template_id"),
+			path.MatchRoot("media_id"),
+		),
+		resourcevalidator.RequiredTogether(
+			path.MatchRoot("media_id"),
+			path.MatchRoot("disk_size"),
+			path.MatchRoot("os_profile"),
+		),
 	}
 }
 
@@ -118,37 +281,50 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 	}
 
 	// Preparar hardware personalizado si se especifica
-	var vcpus *int64
-	var memory *float64
-	var interfaces []string
-	
-	if !plan.VCPUs.IsNull() && !plan.VCPUs.IsUnknown() {
-		v := plan.VCPUs.ValueInt64()
-		vcpus = &v
-	}
-	
-	if !plan.Memory.IsNull() && !plan.Memory.IsUnknown() {
-		m := plan.Memory.ValueFloat64()
-		memory = &m
+	hardware, diags := buildHardwareSpec(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-	
-	if !plan.Interfaces.IsNull() && !plan.Interfaces.IsUnknown() {
-		diags := plan.Interfaces.ElementsAs(ctx, &interfaces, false)
+
+	// Construir guest_properties (viewers, fullscreen y credenciales)
+	var password types.String
+	if plan.Credentials != nil {
+		diags = req.Config.GetAttribute(ctx, path.Root("credentials").AtName("password"), &password)
 		resp.Diagnostics.Append(diags...)
 		if resp.Diagnostics.HasError() {
 			return
 		}
 	}
 
-	// Crear el persistent desktop usando la API
-	desktopID, err := r.client.CreatePersistentDesktop(
-		plan.Name.ValueString(),
-		plan.Description.ValueString(),
-		plan.TemplateID.ValueString(),
-		vcpus,
-		memory,
-		interfaces,
-	)
+	guestProperties, diags := buildGuestProperties(ctx, plan.Viewers, nil, plan.Fullscreen, plan.Credentials, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Crear el desktop desde un template o desde un medio de instalación
+	var desktopID string
+	var err error
+	if !plan.MediaID.IsNull() {
+		desktopID, err = r.client.CreateDesktopFromMedia(
+			plan.Name.ValueString(),
+			plan.Description.ValueString(),
+			plan.MediaID.ValueString(),
+			plan.DiskSize.ValueInt64(),
+			plan.OSProfile.ValueString(),
+			hardware,
+			guestProperties,
+		)
+	} else {
+		desktopID, err = r.client.CreatePersistentDesktop(
+			plan.Name.ValueString(),
+			plan.Description.ValueString(),
+			plan.TemplateID.ValueString(),
+			hardware,
+			guestProperties,
+		)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creando el persistent desktop",
@@ -160,8 +336,34 @@ func (r *vmResource) Create(ctx context.Context, req resource.CreateRequest, res
 	// Actualizar el plan con el ID devuelto por la API
 	plan.ID = types.StringValue(desktopID)
 
-	// No leer valores de hardware - la API devuelve valores del template
-	// Mantener los valores del plan de Terraform
+	// Completar los valores computados que no se han configurado
+	desktop, err := r.client.GetDesktop(desktopID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo el desktop creado",
+			fmt.Sprintf("No se pudo leer el desktop (ID: %s): %s", desktopID, err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(fillUnknownFromDesktop(ctx, desktop, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Habilitar el enlace directo si se ha configurado
+	if plan.DirectLink != nil {
+		token, err := r.client.SetDirectLink(desktopID, true)
+		if err != nil {
+			plan.DirectLink.URL = types.StringNull()
+			resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			resp.Diagnostics.AddError(
+				"Error habilitando el enlace directo",
+				fmt.Sprintf("No se pudo habilitar el enlace directo del desktop (ID: %s): %s", desktopID, err.Error()),
+			)
+			return
+		}
+		plan.DirectLink.URL = types.StringValue(r.client.DirectLinkURL(token))
+	}
 
 	// Escribir el estado
 	diags = resp.State.Set(ctx, plan)
@@ -195,11 +397,37 @@ func (r *vmResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 	// Actualizar el estado con los valores de la API
 	state.Name = types.StringValue(desktop.Name)
 	state.Description = types.StringValue(desktop.Description)
-	state.TemplateID = types.StringValue(desktop.TemplateID)
+	// El origen solo se refleja para desktops creados desde template
+	if !state.TemplateID.IsNull() {
+		state.TemplateID = types.StringValue(desktop.TemplateID)
+	}
 	
-	// No actualizar hardware - la API devuelve valores del template, no los configurados
+	// No actualizar vcpus/memory - la API devuelve valores del template, no los configurados
 	// Mantener los valores del estado de Terraform
 
+	// Refrescar el resto del hardware
+	resp.Diagnostics.Append(refreshHardware(ctx, desktop, &state)...)
+
+	// El enlace directo solo se refleja si se gestiona desde Terraform
+	if state.DirectLink != nil {
+		token, err := r.client.GetDirectLink(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error leyendo el enlace directo",
+				fmt.Sprintf("No se pudo leer el enlace directo del desktop (ID: %s): %s", state.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		if token == "" {
+			state.DirectLink = nil
+		} else {
+			state.DirectLink.URL = types.StringValue(r.client.DirectLinkURL(token))
+		}
+	}
+
+	// Refrescar guest_properties solo si están gestionadas desde Terraform
+	resp.Diagnostics.Append(refreshGuestProperties(ctx, desktop.GuestProps, &state.Viewers, &state.Fullscreen, state.Credentials)...)
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 }
@@ -213,9 +441,133 @@ func (r *vmResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
-	// 1. Usar r.client para actualizar el recurso
-	// err := r.client.UpdateSomething(plan.ID.ValueString(), plan.Name.ValueString())
-	// ...
+	var state vmResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// La contraseña es write-only: solo se envía al configurar las
+	// credenciales o cuando cambia password_version
+	var password types.String
+	if credentialsPasswordChanged(plan.Credentials, state.Credentials) {
+		diags = req.Config.GetAttribute(ctx, path.Root("credentials").AtName("password"), &password)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	guestProperties, diags := buildGuestProperties(ctx, plan.Viewers, nil, plan.Fullscreen, plan.Credentials, password)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Las guest_properties que se dejan de gestionar vuelven al valor del template
+	removed := removedGuestProperties(
+		managedGuestProperties{Viewers: len(plan.Viewers) > 0, Fullscreen: !plan.Fullscreen.IsNull(), Credentials: plan.Credentials != nil},
+		managedGuestProperties{Viewers: len(state.Viewers) > 0, Fullscreen: !state.Fullscreen.IsNull(), Credentials: state.Credentials != nil},
+	)
+	if len(removed) > 0 {
+		var templateGuestProps map[string]interface{}
+		if !plan.TemplateID.IsNull() {
+			template, err := r.client.GetTemplateInfo(plan.TemplateID.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error leyendo el template",
+					fmt.Sprintf("No se pudo leer el template (ID: %s): %s", plan.TemplateID.ValueString(), err.Error()),
+				)
+				return
+			}
+			templateGuestProps, _ = template["guest_properties"].(map[string]interface{})
+		}
+		guestProperties = resetGuestProperties(guestProperties, templateGuestProps, removed)
+	}
+
+	// Construir los datos de actualización
+	updateData := map[string]interface{}{
+		"name": plan.Name.ValueString(),
+	}
+	if !plan.Description.IsUnknown() {
+		updateData["description"] = plan.Description.ValueString()
+	}
+	if guestProperties != nil {
+		updateData["guest_properties"] = guestProperties
+	}
+
+	hardware, diags := buildHardwareSpec(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Dejar de gestionar el perfil de vGPU libera la GPU del desktop
+	var stateReservables *reservablesModel
+	diags = req.State.GetAttribute(ctx, path.Root("reservables"), &stateReservables)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hardware.Reservables = reservablesUpdate(plan.Reservables, stateReservables)
+
+	// Dejar de gestionar el QoS de disco vuelve al perfil sin límites
+	var stateQoSDiskID types.String
+	diags = req.State.GetAttribute(ctx, path.Root("qos_disk_id"), &stateQoSDiskID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	hardware.QoSDiskID = qosDiskIDUpdate(plan.QoSDiskID, stateQoSDiskID)
+
+	if !hardware.IsEmpty() {
+		updateData["hardware"] = hardware
+	}
+
+	err := r.client.UpdateDesktop(plan.ID.ValueString(), updateData)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error actualizando el desktop",
+			fmt.Sprintf("No se pudo actualizar el desktop (ID: %s): %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// Habilitar, rotar o deshabilitar el enlace directo
+	var stateDirectLink *directLinkModel
+	diags = req.State.GetAttribute(ctx, path.Root("direct_link"), &stateDirectLink)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if (plan.DirectLink != nil && plan.DirectLink.URL.IsUnknown()) || (plan.DirectLink == nil && stateDirectLink != nil) {
+		token, err := r.client.SetDirectLink(plan.ID.ValueString(), plan.DirectLink != nil)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error actualizando el enlace directo",
+				fmt.Sprintf("No se pudo actualizar el enlace directo del desktop (ID: %s): %s", plan.ID.ValueString(), err.Error()),
+			)
+			return
+		}
+		if plan.DirectLink != nil {
+			plan.DirectLink.URL = types.StringValue(r.client.DirectLinkURL(token))
+		}
+	}
+
+	// Completar los valores computados que no se han configurado
+	desktop, err := r.client.GetDesktop(plan.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error leyendo el desktop actualizado",
+			fmt.Sprintf("No se pudo leer el desktop (ID: %s): %s", plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	resp.Diagnostics.Append(fillUnknownFromDesktop(ctx, desktop, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -242,3 +594,155 @@ func (r *vmResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 
 	// El estado se elimina automáticamente si la función termina sin errores
 }
+
+// buildHardwareSpec construye el payload de hardware con los valores configurados
+func buildHardwareSpec(ctx context.Context, plan *vmResourceModel) (*client.HardwareSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	hardware := &client.HardwareSpec{}
+
+	if !plan.VCPUs.IsNull() && !plan.VCPUs.IsUnknown() {
+		v := plan.VCPUs.ValueInt64()
+		hardware.VCPUs = &v
+	}
+
+	if !plan.Memory.IsNull() && !plan.Memory.IsUnknown() {
+		m := plan.Memory.ValueFloat64()
+		hardware.Memory = &m
+	}
+
+	if !plan.DiskBus.IsNull() && !plan.DiskBus.IsUnknown() {
+		hardware.DiskBus = plan.DiskBus.ValueString()
+	}
+
+	lists := []struct {
+		value  types.List
+		target *[]string
+	}{
+		{plan.Interfaces, &hardware.Interfaces},
+		{plan.BootOrder, &hardware.BootOrder},
+		{plan.Videos, &hardware.Videos},
+		{plan.Graphics, &hardware.Graphics},
+	}
+	for _, list := range lists {
+		if list.value.IsNull() || list.value.IsUnknown() {
+			continue
+		}
+		diags.Append(list.value.ElementsAs(ctx, list.target, false)...)
+	}
+
+	// ISOs y floppies se envían siempre que se gestionen, incluso vacíos, para poder desconectarlos
+	var isos, floppies []string
+	if !plan.Isos.IsNull() && !plan.Isos.IsUnknown() {
+		isos = []string{}
+		diags.Append(plan.Isos.ElementsAs(ctx, &isos, false)...)
+		hardware.Isos = client.MediaRefs(isos)
+	}
+	if !plan.Floppies.IsNull() && !plan.Floppies.IsUnknown() {
+		floppies = []string{}
+		diags.Append(plan.Floppies.ElementsAs(ctx, &floppies, false)...)
+		hardware.Floppies = client.MediaRefs(floppies)
+	}
+
+	hardware.Reservables = plan.Reservables.toAPI()
+	hardware.QoSDiskID = plan.QoSDiskID.ValueString()
+
+	return hardware, diags
+}
+
+// refreshHardware actualiza el modelo con el hardware leído de domain/info
+func refreshHardware(ctx context.Context, desktop *client.Desktop, model *vmResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	hardware := desktop.Hardware
+
+	if hardware.DiskBus != "" {
+		model.DiskBus = types.StringValue(hardware.DiskBus)
+	}
+
+	lists := []struct {
+		value   []string
+		target  *types.List
+		managed bool
+	}{
+		{hardware.BootOrder, &model.BootOrder, true},
+		{hardware.Videos, &model.Videos, true},
+		{hardware.Graphics, &model.Graphics, true},
+		// ISOs y floppies solo se reflejan si se gestionan desde Terraform
+		{mediaIDs(hardware.Isos), &model.Isos, !model.Isos.IsNull()},
+		{mediaIDs(hardware.Floppies), &model.Floppies, !model.Floppies.IsNull()},
+	}
+	for _, list := range lists {
+		if !list.managed || list.value == nil {
+			continue
+		}
+		value, d := types.ListValueFrom(ctx, types.StringType, list.value)
+		diags.Append(d...)
+		*list.target = value
+	}
+
+	// El perfil de vGPU solo se refleja si se gestiona desde Terraform: el que
+	// hereda del template no debe aparecer en el estado (al quitarlo se liberaría la GPU)
+	if model.Reservables != nil {
+		model.Reservables = reservablesFromAPI(hardware.Reservables)
+	}
+
+	// El QoS de disco solo se refleja si se gestiona desde Terraform
+	if !model.QoSDiskID.IsNull() {
+		qosDiskID := hardware.QoSDiskID
+		if qosDiskID == "" {
+			qosDiskID = client.QoSDiskUnlimited
+		}
+		model.QoSDiskID = types.StringValue(qosDiskID)
+	}
+
+	return diags
+}
+
+// fillUnknownFromDesktop completa los atributos computados que el plan deja desconocidos
+func fillUnknownFromDesktop(ctx context.Context, desktop *client.Desktop, model *vmResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if model.Description.IsUnknown() {
+		model.Description = types.StringValue(desktop.Description)
+	}
+	if model.VCPUs.IsUnknown() {
+		model.VCPUs = types.Int64Value(desktop.VCPUs)
+	}
+	if model.Memory.IsUnknown() {
+		model.Memory = types.Float64Value(desktop.Memory)
+	}
+	if model.DiskBus.IsUnknown() {
+		model.DiskBus = types.StringValue(desktop.Hardware.DiskBus)
+	}
+
+	lists := []struct {
+		value  []string
+		target *types.List
+	}{
+		{desktop.Hardware.BootOrder, &model.BootOrder},
+		{desktop.Hardware.Videos, &model.Videos},
+		{desktop.Hardware.Graphics, &model.Graphics},
+	}
+	for _, list := range lists {
+		if !list.target.IsUnknown() {
+			continue
+		}
+		value := list.value
+		if value == nil {
+			value = []string{}
+		}
+		listValue, d := types.ListValueFrom(ctx, types.StringType, value)
+		diags.Append(d...)
+		*list.target = listValue
+	}
+
+	return diags
+}
+
+// mediaIDs convierte las referencias de medios en una lista de IDs (nunca nil)
+func mediaIDs(refs []client.MediaRef) []string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	return ids
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/tknika/terraform-provider-isard/internal/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource              = &volatileDesktopResource{}
+	_ resource.ResourceWithConfigure = &volatileDesktopResource{}
+)
+
+const (
+	// volatilePollInterval es el intervalo entre consultas del estado del desktop
+	volatilePollInterval = 5 * time.Second
+	// volatileStartTimeout es el tiempo máximo de espera hasta que el desktop arranca
+	volatileStartTimeout = 10 * time.Minute
+)
+
+// browserViewerTypes son los viewers que devuelven una URL para abrir en el navegador
+var browserViewerTypes = []string{"browser_vnc", "browser_rdp"}
+
+// NewVolatileDesktopResource is a helper function to simplify the provider implementation.
+func NewVolatileDesktopResource() resource.Resource {
+	return &volatileDesktopResource{}
+}
+
+// volatileDesktopResource is the resource implementation.
+type volatileDesktopResource struct {
+	client *client.Client
+}
+
+// volatileDesktopResourceModel maps the resource schema data.
+type volatileDesktopResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	TemplateID types.String `tfsdk:"template_id"`
+	ViewerType types.String `tfsdk:"viewer_type"`
+	Name       types.String `tfsdk:"name"`
+	Status     types.String `tfsdk:"status"`
+	ViewerURL  types.String `tfsdk:"viewer_url"`
+}
+
+// Metadata returns the resource type name.
+func (r *volatileDesktopResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_volatile_desktop"
+}
+
+// Schema defines the schema for the resource.
+func (r *volatileDesktopResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Gestiona un desktop no persistente (volátil) en Isard VDI. Se crea arrancado a partir de un template y se destruye al detenerse.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identificador único del desktop",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ID de la plantilla a partir de la que se crea el desktop",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"viewer_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("browser_vnc"),
+				MarkdownDescription: "Viewer de navegador con el que se obtiene `viewer_url` (browser_vnc, browser_rdp). Por defecto: browser_vnc",
+				Validators: []validator.String{
+					stringvalidator.OneOf(browserViewerTypes...),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Nombre asignado al desktop por Isard",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Estado del desktop",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"viewer_url": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "URL para abrir el desktop en el navegador",
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *volatileDesktopResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *volatileDesktopResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan volatileDesktopResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desktopID, err := r.client.CreateVolatileDesktop(plan.TemplateID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creando desktop volátil",
+			"No se pudo crear el desktop volátil: "+err.Error(),
+		)
+		return
+	}
+
+	// Esperar a que el desktop arranque
+	desktop, err := r.waitForStart(ctx, desktopID)
+	if err != nil {
+		// Eliminar el desktop para no dejar recursos huérfanos
+		_ = r.client.DeleteDesktop(desktopID)
+		resp.Diagnostics.AddError(
+			"Error arrancando desktop volátil",
+			"El desktop "+desktopID+" no arrancó: "+err.Error(),
+		)
+		return
+	}
+
+	viewer, err := r.client.GetDesktopViewer(desktopID, plan.ViewerType.ValueString())
+	if err != nil {
+		_ = r.client.DeleteDesktop(desktopID)
+		resp.Diagnostics.AddError(
+			"Error obteniendo viewer",
+			"No se pudo obtener el viewer "+plan.ViewerType.ValueString()+" del desktop "+desktopID+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(desktopID)
+	plan.Name = types.StringValue(desktop.Name)
+	plan.Status = types.StringValue(desktop.Status)
+	plan.ViewerURL = types.StringValue(viewer.URL)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+// Un desktop volátil detenido ya no existe: se elimina del estado para que
+// el siguiente apply cree uno nuevo y limpio.
+func (r *volatileDesktopResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state volatileDesktopResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	desktop, err := r.client.GetDesktop(state.ID.ValueString())
+	if err != nil {
+		if err.Error() == "desktop not found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error leyendo desktop volátil",
+			"No se pudo leer el desktop ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	if desktop.Status == client.DesktopStatusStopped || desktop.Status == client.DesktopStatusFailed {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Name = types.StringValue(desktop.Name)
+	state.Status = types.StringValue(desktop.Status)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+// Solo viewer_type se puede modificar: se vuelve a obtener la URL del viewer.
+func (r *volatileDesktopResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan volatileDesktopResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	viewer, err := r.client.GetDesktopViewer(plan.ID.ValueString(), plan.ViewerType.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error obteniendo viewer",
+			"No se pudo obtener el viewer "+plan.ViewerType.ValueString()+" del desktop "+plan.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	plan.ViewerURL = types.StringValue(viewer.URL)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *volatileDesktopResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state volatileDesktopResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Eliminar el desktop lo detiene; si ya se detuvo, Isard lo habrá eliminado (404)
+	err := r.client.DeleteDesktop(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error eliminando desktop volátil",
+			"No se pudo eliminar el desktop ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// waitForStart consulta el estado del desktop hasta que está arrancado
+func (r *volatileDesktopResource) waitForStart(ctx context.Context, desktopID string) (*client.Desktop, error) {
+	deadline := time.After(volatileStartTimeout)
+	ticker := time.NewTicker(volatilePollInterval)
+	defer ticker.Stop()
+
+	for {
+		desktop, err := r.client.GetDesktop(desktopID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch desktop.Status {
+		case client.DesktopStatusStarted:
+			return desktop, nil
+		case client.DesktopStatusFailed:
+			return nil, fmt.Errorf("el desktop terminó con estado %s", desktop.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-deadline:
+			return nil, fmt.Errorf("el desktop no arrancó en %s (último estado: %s)", volatileStartTimeout, desktop.Status)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ planmodifier.String = rotateTriggerPlanModifier{}
+
+// rotateTriggerPlanModifier conserva un valor generado por Isard (código de
+// inscripción, enlace directo...) mientras no cambie el atributo hermano
+// rotate_trigger, y lo marca como desconocido al rotarlo.
+type rotateTriggerPlanModifier struct{}
+
+func (m rotateTriggerPlanModifier) Description(_ context.Context) string {
+	return "Mantiene el valor generado salvo que cambie rotate_trigger."
+}
+
+func (m rotateTriggerPlanModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m rotateTriggerPlanModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Valor recién habilitado o recurso nuevo: se genera en el apply
+	if req.StateValue.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+
+	triggerPath := req.Path.ParentPath().AtName("rotate_trigger")
+
+	var planTrigger, stateTrigger types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, triggerPath, &planTrigger)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, triggerPath, &stateTrigger)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if planTrigger.Equal(stateTrigger) {
+		resp.PlanValue = req.StateValue
+	}
+}